@@ -0,0 +1,121 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogDeletionRequestSpec defines the desired state of LogDeletionRequest
+type LogDeletionRequestSpec struct {
+	// StackName is the name of the LokiStack in the same namespace
+	// whose compactor processes the deletion request.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="LokiStack Name"
+	StackName string `json:"stackName"`
+
+	// TenantID of the tenant whose logs are deleted.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant ID"
+	TenantID string `json:"tenantID"`
+
+	// Query is the LogQL stream selector, optionally followed by line filters,
+	// selecting the log lines to delete.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query"
+	Query string `json:"query"`
+
+	// Start is the beginning of the time range of the log lines to delete.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start"
+	Start metav1.Time `json:"start"`
+
+	// End is the end of the time range of the log lines to delete.
+	// Defaults to the time the request is submitted.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="End"
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// LogDeletionRequestPhase is the phase of a LogDeletionRequest.
+//
+// +kubebuilder:validation:Enum=Pending;Processed;Failed
+type LogDeletionRequestPhase string
+
+const (
+	// LogDeletionRequestPending defines a request that is not yet
+	// submitted to or processed by the compactor.
+	LogDeletionRequestPending LogDeletionRequestPhase = "Pending"
+	// LogDeletionRequestProcessed defines a request that the compactor processed.
+	LogDeletionRequestProcessed LogDeletionRequestPhase = "Processed"
+	// LogDeletionRequestFailed defines a request that cannot be processed.
+	LogDeletionRequestFailed LogDeletionRequestPhase = "Failed"
+)
+
+// LogDeletionRequestStatus defines the observed state of LogDeletionRequest
+type LogDeletionRequestStatus struct {
+	// Phase of the deletion request.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Phase"
+	Phase LogDeletionRequestPhase `json:"phase,omitempty"`
+
+	// Message describes the current phase.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Message"
+	Message string `json:"message,omitempty"`
+
+	// SubmittedAt is the time the request was submitted to the compactor.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Submitted At"
+	SubmittedAt *metav1.Time `json:"submittedAt,omitempty"`
+
+	// End is the end of the time range submitted to the compactor.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="End"
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Stack",type=string,JSONPath=`.spec.stackName`
+//+kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantID`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LogDeletionRequest is the Schema for the logdeletionrequests API
+type LogDeletionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogDeletionRequestSpec   `json:"spec,omitempty"`
+	Status LogDeletionRequestStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LogDeletionRequestList contains a list of LogDeletionRequest
+type LogDeletionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogDeletionRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogDeletionRequest{}, &LogDeletionRequestList{})
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package v1
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,6 +28,10 @@ const (
 	// The AnnotationDisableTenantValidation annotation can contain a boolean value that, if true, disables the tenant-ID validation.
 	AnnotationDisableTenantValidation = "loki.grafana.com/disable-tenant-validation"
 
+	// The AnnotationDisableStoragePreflight annotation can contain a boolean value that, if true, disables the
+	// object storage connectivity check run before rolling out the LokiStack components.
+	AnnotationDisableStoragePreflight = "loki.grafana.com/disable-storage-preflight"
+
 	// The AnnotationAvailabilityZone annotation contains the availability zone used in the Loki configuration of that pod.
 	// It is automatically added to managed Pods by the operator, if needed.
 	AnnotationAvailabilityZone = "loki.grafana.com/availability-zone"
@@ -37,6 +44,18 @@ const (
 	// LabelZoneAwarePod is a pod-label that is added to Pods that should be reconciled by the zone-awareness controller.
 	// It is automatically added to managed Pods by the operator, if needed.
 	LabelZoneAwarePod string = "loki.grafana.com/zone-aware"
+
+	// LabelIngesterZone is a label added to the ingester StatefulSets and Pods of the statefulset-per-zone
+	// zone mode. It contains the zone value the ingesters are pinned to.
+	LabelIngesterZone string = "loki.grafana.com/ingester-zone"
+
+	// AnnotationRulesDiscoveredAt stores the last timestamp the operator discovered
+	// alerting or recording rules for a LokiStack. It is used to trigger a new reconciliation.
+	AnnotationRulesDiscoveredAt string = "loki.grafana.com/rulesDiscoveredAt"
+
+	// AnnotationRulerConfigDiscoveredAt stores the last timestamp the operator discovered
+	// a RulerConfig for a LokiStack. It is used to trigger a new reconciliation.
+	AnnotationRulerConfigDiscoveredAt string = "loki.grafana.com/rulerConfigDiscoveredAt"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -98,6 +117,393 @@ type LokiStackSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replication Spec"
 	Replication *ReplicationSpec `json:"replication,omitempty"`
+
+	// Ingester defines the configuration for the ingester component.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced",displayName="Ingester"
+	Ingester *IngesterSpec `json:"ingester,omitempty"`
+
+	// Tenants defines the per-tenant authentication and authorization spec for the lokistack-gateway component.
+	// The gateway is only deployed when this section is set.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenants Configuration"
+	Tenants *TenantsSpec `json:"tenants,omitempty"`
+
+	// Rules defines the spec for the ruler component.
+	// The ruler is only deployed when this section is set and enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced",displayName="Rules"
+	Rules *RulesSpec `json:"rules,omitempty"`
+}
+
+// IngesterSpec defines the configuration for the ingester component.
+type IngesterSpec struct {
+	// WAL defines the write-ahead log of the ingesters stored on their storage volume.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Write-Ahead Log"
+	WAL *IngesterWALSpec `json:"wal,omitempty"`
+}
+
+// IngesterWALSpec defines the write-ahead log of the ingesters.
+type IngesterWALSpec struct {
+	// Enabled defines a flag to enable/disable the write-ahead log.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Enable"
+	Enabled bool `json:"enabled"`
+
+	// ReplayMemoryCeiling defines the memory an ingester may use when replaying the write-ahead log
+	// on startup, before flushing the replayed chunks to the object storage.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replay Memory Ceiling"
+	ReplayMemoryCeiling *resource.Quantity `json:"replayMemoryCeiling,omitempty"`
+
+	// CheckpointDuration defines the interval at which the write-ahead log is checkpointed.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:="^((([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Checkpoint Duration"
+	CheckpointDuration string `json:"checkpointDuration,omitempty"`
+}
+
+// RulesSpec defines the spec for the ruler component.
+type RulesSpec struct {
+	// Enabled defines a flag to enable/disable the ruler component
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Enable"
+	Enabled bool `json:"enabled"`
+
+	// A selector to select which AlertingRules and RecordingRules to mount for loading
+	// rules from.
+	//
+	// +optional
+	// +kubebuilder:validation:optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Selector"
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Namespaces to be selected for rules discovery. If unspecified, only
+	// the same namespace as the LokiStack object is in is used.
+	//
+	// +optional
+	// +kubebuilder:validation:optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector"
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// RemoteWrite defines the remote write configuration to send the samples
+	// produced by recording rules to a Prometheus-compatible endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Remote Write Configuration"
+	RemoteWrite *RemoteWriteSpec `json:"remoteWrite,omitempty"`
+}
+
+// RemoteWriteAuthType defines the type of authorization to use to access the remote write endpoint.
+//
+// +kubebuilder:validation:Enum=basic;header
+type RemoteWriteAuthType string
+
+const (
+	// BasicAuthorization defines the remote write client to use HTTP basic authorization.
+	// The referenced secret needs to provide the keys `username` and `password`.
+	BasicAuthorization RemoteWriteAuthType = "basic"
+	// BearerAuthorization defines the remote write client to use HTTP header authorization.
+	// The referenced secret needs to provide the key `bearer_token`.
+	BearerAuthorization RemoteWriteAuthType = "header"
+)
+
+// RemoteWriteSpec defines the configuration for ruler's remote_write connectivity.
+type RemoteWriteSpec struct {
+	// Enable remote-write functionality.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Enabled"
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Minimum period to wait between refreshing remote-write reconfigurations.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="10s"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Refresh Period"
+	RefreshPeriod PrometheusDuration `json:"refreshPeriod,omitempty"`
+
+	// Defines the configuration for remote write client.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client"
+	Client *RemoteWriteClientSpec `json:"client,omitempty"`
+}
+
+// RemoteWriteClientSpec defines the configuration of the remote write client.
+type RemoteWriteClientSpec struct {
+	// Name of the remote write config, which if specified must be unique among remote write configs.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// The URL of the endpoint to send samples to.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Endpoint"
+	URL string `json:"url"`
+
+	// Timeout for requests to the remote write endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="30s"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Remote Write Timeout"
+	Timeout PrometheusDuration `json:"timeout,omitempty"`
+
+	// Type of authorization to use to access the remote write endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:basic","urn:alm:descriptor:com.tectonic.ui:select:header"},displayName="Authorization Type"
+	AuthorizationType RemoteWriteAuthType `json:"authorization,omitempty"`
+
+	// Name of a secret in the LokiStack namespace providing the authorization credentials.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret",displayName="Authorization Secret Name"
+	AuthorizationSecretName string `json:"authorizationSecretName,omitempty"`
+
+	// Additional HTTP headers to be sent along with each remote write request.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	AdditionalHeaders map[string]string `json:"additionalHeaders,omitempty"`
+
+	// Configure whether HTTP requests follow HTTP 3xx redirects.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=true
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Follow HTTP Redirects"
+	FollowRedirects bool `json:"followRedirects"`
+}
+
+// PermissionType is a LokiStack Gateway RBAC permission.
+//
+// +kubebuilder:validation:Enum=read;write
+type PermissionType string
+
+const (
+	// Write gives access to write data to a tenant.
+	Write PermissionType = "write"
+	// Read gives access to read data from a tenant.
+	Read PermissionType = "read"
+)
+
+// SubjectKind is a kind of LokiStack Gateway RBAC subject.
+//
+// +kubebuilder:validation:Enum=user;group
+type SubjectKind string
+
+const (
+	// User represents a subject that is a user.
+	User SubjectKind = "user"
+	// Group represents a subject that is a group.
+	Group SubjectKind = "group"
+)
+
+// Subject represents a subject that has been bound to a role.
+type Subject struct {
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Kind SubjectKind `json:"kind"`
+}
+
+// RoleBindingsSpec binds a set of roles to a set of subjects.
+type RoleBindingsSpec struct {
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Subjects []Subject `json:"subjects"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Roles []string `json:"roles"`
+}
+
+// RoleSpec describes a set of permissions to interact with a tenant.
+type RoleSpec struct {
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Resources []string `json:"resources"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Tenants []string `json:"tenants"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	Permissions []PermissionType `json:"permissions"`
+}
+
+// AuthorizationSpec defines the static roles and role bindings used in static mode.
+type AuthorizationSpec struct {
+	// Roles defines a set of permissions to interact with a tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Static Roles"
+	Roles []RoleSpec `json:"roles,omitempty"`
+
+	// RoleBindings defines configuration to bind a set of roles to a set of subjects.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Static Role Bindings"
+	RoleBindings []RoleBindingsSpec `json:"roleBindings,omitempty"`
+}
+
+// TenantSecretSpec is a secret reference containing name only
+// for a secret living in the same namespace as the LokiStack custom resource.
+type TenantSecretSpec struct {
+	// Name of a secret in the namespace configured for tenant secrets.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret",displayName="Tenant Secret Name"
+	Name string `json:"name"`
+}
+
+// OIDCSpec defines the oidc configuration spec for lokiStack Gateway component.
+type OIDCSpec struct {
+	// Secret defines the spec for the clientID and clientSecret for tenant's authentication.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Secret"
+	Secret *TenantSecretSpec `json:"secret"`
+
+	// IssuerURL defines the URL for issuer.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer URL"
+	IssuerURL string `json:"issuerURL"`
+
+	// RedirectURL defines the URL for redirect.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redirect URL"
+	RedirectURL string `json:"redirectURL,omitempty"`
+
+	// Group claim field from ID Token
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	GroupClaim string `json:"groupClaim,omitempty"`
+
+	// User claim field from ID Token
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+}
+
+// AuthenticationSpec defines the oidc configuration per tenant for lokiStack Gateway component.
+type AuthenticationSpec struct {
+	// TenantName defines the name of the tenant.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Name"
+	TenantName string `json:"tenantName"`
+
+	// TenantID defines the id of the tenant.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant ID"
+	TenantID string `json:"tenantId"`
+
+	// OIDC defines the spec for the OIDC tenant's authentication.
+	// Required in static and oidc mode, ignored in passthrough mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OIDC Configuration"
+	OIDC *OIDCSpec `json:"oidc,omitempty"`
+}
+
+// ModeType is the authentication/authorization mode in which LokiStack Gateway will be configured.
+//
+// +kubebuilder:validation:Enum=static;oidc;passthrough
+type ModeType string
+
+const (
+	// Static mode authenticates tenants via OIDC and authorizes subjects
+	// using the Roles and RoleBindings declared in the AuthorizationSpec.
+	Static ModeType = "static"
+	// OIDC mode authenticates tenants via OIDC and grants read and write
+	// access on a tenant to every member of the group named like the tenant.
+	OIDC ModeType = "oidc"
+	// Passthrough mode does not authenticate requests. The gateway only
+	// routes them and forwards the X-Scope-OrgID header sent by clients.
+	Passthrough ModeType = "passthrough"
+)
+
+// TenantsSpec defines the mode, authentication and authorization
+// configuration of the lokiStack gateway component.
+type TenantsSpec struct {
+	// Mode defines the mode in which lokistack-gateway component will be configured.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default:=static
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:static","urn:alm:descriptor:com.tectonic.ui:select:oidc","urn:alm:descriptor:com.tectonic.ui:select:passthrough"},displayName="Mode"
+	Mode ModeType `json:"mode"`
+
+	// Authentication defines the lokistack-gateway component authentication configuration spec per tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication"
+	Authentication []AuthenticationSpec `json:"authentication,omitempty"`
+
+	// Authorization defines the lokistack-gateway component authorization configuration spec per tenant.
+	// Only used in static mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authorization"
+	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
 }
 
 // HashRingSpec defines the hash ring configuration
@@ -241,6 +647,32 @@ type ObjectStorageSpec struct {
 	// +required
 	// +kubebuilder:validation:Required
 	Secret ObjectStorageSecretSpec `json:"secret"`
+
+	// TLS configuration for reaching the object storage endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Config"
+	TLS *ObjectStorageTLSSpec `json:"tls,omitempty"`
+}
+
+// ObjectStorageTLSSpec is the TLS configuration for reaching the object storage endpoint.
+type ObjectStorageTLSSpec struct {
+	// CA is the name of a ConfigMap containing a CA certificate.
+	// It needs to be in the same namespace as the LokiStack custom resource.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ConfigMap",displayName="CA ConfigMap Name"
+	CA string `json:"caName"`
+
+	// CAKey is the data key of the ConfigMap containing the CA certificate.
+	// If empty, it defaults to "service-ca.crt".
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CA ConfigMap Key"
+	CAKey string `json:"caKey,omitempty"`
 }
 
 // ObjectStorageSchema defines a schema version and the date when it will become effective.
@@ -287,25 +719,46 @@ type ObjectStorageSecretSpec struct {
 	//
 	// +required
 	// +kubebuilder:validation:Required
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:azure","urn:alm:descriptor:com.tectonic.ui:select:gcs","urn:alm:descriptor:com.tectonic.ui:select:s3","urn:alm:descriptor:com.tectonic.ui:select:swift","urn:alm:descriptor:com.tectonic.ui:select:alibabacloud"},displayName="Object Storage Secret Type"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:azure","urn:alm:descriptor:com.tectonic.ui:select:gcs","urn:alm:descriptor:com.tectonic.ui:select:s3","urn:alm:descriptor:com.tectonic.ui:select:swift","urn:alm:descriptor:com.tectonic.ui:select:alibabacloud","urn:alm:descriptor:com.tectonic.ui:select:filesystem","urn:alm:descriptor:com.tectonic.ui:select:minio"},displayName="Object Storage Secret Type"
 	Type ObjectStorageSecretType `json:"type"`
 
 	// Name of a secret in the namespace configured for object storage secrets.
+	// Required for all types except filesystem and minio.
 	//
-	// +required
-	// +kubebuilder:validation:Required
+	// +optional
+	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret",displayName="Object Storage Secret Name"
 	Name string `json:"name"`
 }
 
 // ObjectStorageSecretType defines the type of storage which can be used with the Loki cluster.
 //
-// +kubebuilder:validation:Enum=azure;gcs;s3;swift;alibabacloud;
+// +kubebuilder:validation:Enum=azure;gcs;s3;swift;alibabacloud;filesystem;minio
 type ObjectStorageSecretType string
 
 const (
+	// ObjectStorageSecretAzure when using Azure for Loki storage
+	ObjectStorageSecretAzure ObjectStorageSecretType = "azure"
+
+	// ObjectStorageSecretGCS when using GCS for Loki storage
+	ObjectStorageSecretGCS ObjectStorageSecretType = "gcs"
+
 	// ObjectStorageSecretS3 when using S3 for Loki storage
 	ObjectStorageSecretS3 ObjectStorageSecretType = "s3"
+
+	// ObjectStorageSecretSwift when using Swift for Loki storage
+	ObjectStorageSecretSwift ObjectStorageSecretType = "swift"
+
+	// ObjectStorageSecretAlibabaCloud when using AlibabaCloud OSS for Loki storage
+	ObjectStorageSecretAlibabaCloud ObjectStorageSecretType = "alibabacloud"
+
+	// ObjectStorageSecretFileSystem when using a persistent volume shared by all
+	// components for Loki storage. It is supported only for single-replica stacks.
+	ObjectStorageSecretFileSystem ObjectStorageSecretType = "filesystem"
+
+	// ObjectStorageSecretMinIO when using a single-node MinIO deployed by the operator
+	// for Loki storage. It is supported only for demo stacks.
+	ObjectStorageSecretMinIO ObjectStorageSecretType = "minio"
 )
 
 // LokiStackStatus defines the observed state of LokiStack
@@ -547,12 +1000,54 @@ const (
 	ReasonInvalidObjectStorageSchema LokiStackConditionReason = "InvalidObjectStorageSchema"
 	// ReasonInvalidObjectStorageSecret when the format of the secret is invalid.
 	ReasonInvalidObjectStorageSecret LokiStackConditionReason = "InvalidObjectStorageSecret"
+	// ReasonMissingObjectStorageCAConfigMap when the required configmap to verify object storage
+	// certificates is missing.
+	ReasonMissingObjectStorageCAConfigMap LokiStackConditionReason = "MissingObjectStorageCAConfigMap"
+	// ReasonInvalidObjectStorageCAConfigMap when the object storage CA configmap does not
+	// contain the configured key.
+	ReasonInvalidObjectStorageCAConfigMap LokiStackConditionReason = "InvalidObjectStorageCAConfigMap"
+	// ReasonObjectStorageUnreachable when the object storage endpoint or bucket cannot be
+	// reached by the connectivity check.
+	ReasonObjectStorageUnreachable LokiStackConditionReason = "ObjectStorageUnreachable"
+	// ReasonObjectStorageAccessDenied when the object storage rejects the credentials of
+	// the storage secret in the connectivity check.
+	ReasonObjectStorageAccessDenied LokiStackConditionReason = "ObjectStorageAccessDenied"
+	// ReasonInvalidMinIOStorage when the operator-managed MinIO storage is used
+	// with a stack size other than 1x.demo.
+	ReasonInvalidMinIOStorage LokiStackConditionReason = "InvalidMinIOStorage"
+	// ReasonInvalidFileSystemStorage when filesystem storage is used with a stack
+	// that runs more than a single replica of a component accessing the storage.
+	ReasonInvalidFileSystemStorage LokiStackConditionReason = "InvalidFileSystemStorage"
 	// ReasonZoneAwareNodesMissing when the cluster does not contain any nodes with the labels needed for zone-awareness.
 	ReasonZoneAwareNodesMissing LokiStackConditionReason = "ReasonZoneAwareNodesMissing"
 	// ReasonZoneAwareEmptyLabel when the node-label used for zone-awareness has an empty value.
 	ReasonZoneAwareEmptyLabel LokiStackConditionReason = "ReasonZoneAwareEmptyLabel"
+	// ReasonZoneAwareInsufficientZones when the cluster contains fewer zones for zone-awareness
+	// than the replication factor.
+	ReasonZoneAwareInsufficientZones LokiStackConditionReason = "ReasonZoneAwareInsufficientZones"
+	// ReasonInvalidReplicationConfiguration when the replication factor exceeds
+	// the number of ingester replicas or available zones.
+	ReasonInvalidReplicationConfiguration LokiStackConditionReason = "InvalidReplicationConfiguration"
+	// ReasonInvalidZoneModeConfiguration when the statefulset-per-zone zone mode is used
+	// without a single zone spec listing the zone values.
+	ReasonInvalidZoneModeConfiguration LokiStackConditionReason = "InvalidZoneModeConfiguration"
 	// ReasonStorageNeedsSchemaUpdate when the object storage schema version is older than V13
 	ReasonStorageNeedsSchemaUpdate LokiStackConditionReason = "StorageNeedsSchemaUpdate"
+	// ReasonMissingGatewayTenantSecret when the required tenant secret
+	// for authentication is missing.
+	ReasonMissingGatewayTenantSecret LokiStackConditionReason = "MissingGatewayTenantSecret"
+	// ReasonInvalidGatewayTenantSecret when the format of the secret is invalid.
+	ReasonInvalidGatewayTenantSecret LokiStackConditionReason = "InvalidGatewayTenantSecret"
+	// ReasonInvalidTenantsConfiguration when the tenant configuration provided is invalid.
+	ReasonInvalidTenantsConfiguration LokiStackConditionReason = "InvalidTenantsConfiguration"
+	// ReasonMissingRulerSecret when the required secret to authorize remote write connections
+	// for the ruler is missing.
+	ReasonMissingRulerSecret LokiStackConditionReason = "MissingRulerSecret"
+	// ReasonInvalidRulerSecret when the secret to authorize remote write connections for the
+	// ruler does not contain the keys required by the authorization type.
+	ReasonInvalidRulerSecret LokiStackConditionReason = "InvalidRulerSecret"
+	// ReasonInvalidRulerConfig when the RulerConfig for the LokiStack is invalid.
+	ReasonInvalidRulerConfig LokiStackConditionReason = "InvalidRulerConfig"
 )
 
 // LokiStackStorageStatus defines the observed state of
@@ -570,6 +1065,14 @@ type LokiStackStorageStatus struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	CredentialMode CredentialMode `json:"credentialMode,omitempty"`
+
+	// PreflightHash is the hash of the object storage secret and CA certificate
+	// last verified by the object storage connectivity check. The check runs again
+	// only when either of them changes.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	PreflightHash string `json:"preflightHash,omitempty"`
 }
 
 // LimitsSpec defines the spec for limits applied at ingestion or query
@@ -581,6 +1084,13 @@ type LimitsSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Global Limits"
 	Global *LimitsTemplateSpec `json:"global,omitempty"`
+
+	// Tenants defines the limits applied per tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Limits per Tenant"
+	Tenants map[string]PerTenantLimitsTemplateSpec `json:"tenants,omitempty"`
 }
 
 // LimitsTemplateSpec defines the limits  applied at ingestion or query path.
@@ -596,6 +1106,33 @@ type LimitsTemplateSpec struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	QueryLimits *QueryLimitSpec `json:"queries,omitempty"`
+
+	// Retention defines how long logs are kept in storage.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	Retention *RetentionLimitSpec `json:"retention,omitempty"`
+}
+
+// PerTenantLimitsTemplateSpec defines the limits applied at ingestion or query path.
+type PerTenantLimitsTemplateSpec struct {
+	// IngestionLimits defines the limits applied on ingested log streams.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	IngestionLimits *IngestionLimitSpec `json:"ingestion,omitempty"`
+
+	// QueryLimits defines the limit applied on querying log streams.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	QueryLimits *PerTenantQueryLimitSpec `json:"queries,omitempty"`
+
+	// Retention defines how long logs are kept in storage.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	Retention *RetentionLimitSpec `json:"retention,omitempty"`
 }
 
 // IngestionLimitSpec defines the limits applied at the ingestion path.
@@ -727,6 +1264,118 @@ type QueryLimitSpec struct {
 	MaxVolumeSeries int32 `json:"maxVolumeSeries,omitempty"`
 }
 
+// RetentionLimitSpec controls how long logs will be kept in storage.
+type RetentionLimitSpec struct {
+	// Days contains the number of days logs are kept.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Days"
+	Days uint `json:"days"`
+
+	// Stream defines the log stream.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Streams"
+	Streams []*RetentionStreamSpec `json:"streams,omitempty"`
+}
+
+// RetentionStreamSpec defines a log stream with separate retention time.
+type RetentionStreamSpec struct {
+	// Days contains the number of days logs are kept.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Days"
+	Days uint `json:"days"`
+
+	// Priority defines the priority of this selector compared to other retention rules.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Priority"
+	Priority uint32 `json:"priority,omitempty"`
+
+	// Selector contains the LogQL query used to define the log stream.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Selector"
+	Selector string `json:"selector"`
+}
+
+// BlockedQuerySpec defines the rule spec for queries to be blocked.
+//
+// +kubebuilder:validation:MinProperties:=1
+type BlockedQuerySpec struct {
+	// Hash is a 32-bit FNV-1 hash of the query string.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Query Hash"
+	Hash int32 `json:"hash,omitempty"`
+	// Pattern defines the pattern matching the queries to be blocked.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query Pattern"
+	Pattern string `json:"pattern,omitempty"`
+	// Regex defines if the pattern is a regular expression. If false the pattern will be used only for exact matches.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Regex"
+	Regex bool `json:"regex,omitempty"`
+	// Types defines the list of query types that should be considered for blocking.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query Types"
+	Types BlockedQueryTypes `json:"types,omitempty"`
+}
+
+// BlockedQueryType defines which type of query a blocked query should apply to.
+//
+// +kubebuilder:validation:Enum=filter;limited;metric
+type BlockedQueryType string
+
+const (
+	// BlockedQueryFilter is used, when the blocked query should apply to queries using a log filter.
+	BlockedQueryFilter BlockedQueryType = "filter"
+	// BlockedQueryLimited is used, when the blocked query should apply to queries without a filter or a metric aggregation.
+	BlockedQueryLimited BlockedQueryType = "limited"
+	// BlockedQueryMetric is used, when the blocked query should apply to queries with an aggregation.
+	BlockedQueryMetric BlockedQueryType = "metric"
+)
+
+// BlockedQueryTypes defines a slice of BlockedQueryType values to be used for a blocked query.
+type BlockedQueryTypes []BlockedQueryType
+
+func (t BlockedQueryTypes) String() string {
+	res := make([]string, 0, len(t))
+	for _, t := range t {
+		res = append(res, string(t))
+	}
+
+	return strings.Join(res, ",")
+}
+
+// PerTenantQueryLimitSpec defines the limits applied to per tenant query path.
+type PerTenantQueryLimitSpec struct {
+	QueryLimitSpec `json:",omitempty"`
+
+	// Blocked defines the list of rules to block matching queries.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Blocked"
+	Blocked []BlockedQuerySpec `json:"blocked,omitempty"`
+}
+
 type ReplicationSpec struct {
 	// Factor defines the policy for log stream replication.
 	//
@@ -743,8 +1392,31 @@ type ReplicationSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Zones Spec"
 	Zones []ZoneSpec `json:"zones,omitempty"`
+
+	// ZoneMode defines how the ingesters are distributed across the zones.
+	// With statefulset-per-zone a single zone spec with values is required.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=topology-spread
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:topology-spread","urn:alm:descriptor:com.tectonic.ui:select:statefulset-per-zone"},displayName="Zone Mode"
+	ZoneMode ReplicationZoneMode `json:"zoneMode,omitempty"`
 }
 
+// ReplicationZoneMode defines how the ingesters are distributed across the zones.
+//
+// +kubebuilder:validation:Enum=topology-spread;statefulset-per-zone
+type ReplicationZoneMode string
+
+const (
+	// ReplicationZoneModeTopologySpread runs a single ingester StatefulSet whose
+	// pods are spread across the zones by topology spread constraints.
+	ReplicationZoneModeTopologySpread ReplicationZoneMode = "topology-spread"
+	// ReplicationZoneModeStatefulSetPerZone runs one ingester StatefulSet pinned
+	// to each zone value, which are rolled out one zone at a time.
+	ReplicationZoneModeStatefulSetPerZone ReplicationZoneMode = "statefulset-per-zone"
+)
+
 // ZoneSpec defines the spec to support zone-aware component deployments.
 type ZoneSpec struct {
 	// MaxSkew describes the maximum degree to which Pods can be unevenly distributed.
@@ -760,6 +1432,14 @@ type ZoneSpec struct {
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Topology Key"
 	TopologyKey string `json:"topologyKey"`
+
+	// Values lists the values of the topology key, i.e. the zones, used
+	// to pin the ingesters with the statefulset-per-zone zone mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Zone Values"
+	Values []string `json:"values,omitempty"`
 }
 
 // CredentialMode represents the type of authentication used for accessing the object storage.
@@ -767,6 +1447,16 @@ type ZoneSpec struct {
 // +kubebuilder:validation:Enum=static;token;token-cco
 type CredentialMode string
 
+const (
+	// CredentialModeStatic represents the usage of static, long-lived credentials stored in a Secret.
+	CredentialModeStatic CredentialMode = "static"
+	// CredentialModeToken represents the usage of short-lived tokens retrieved using a role
+	// and the bound service account token of the pods.
+	CredentialModeToken CredentialMode = "token"
+	// CredentialModeTokenCCO represents the usage of short-lived tokens issued by a cloud credential operator.
+	CredentialModeTokenCCO CredentialMode = "token-cco"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -0,0 +1,290 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertManagerDiscoverySpec defines the configuration to use DNS resolution for AlertManager hosts.
+type AlertManagerDiscoverySpec struct {
+	// Use DNS SRV records to discover Alertmanager hosts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch",displayName="Enable SRV"
+	EnableSRV bool `json:"enableSRV"`
+
+	// How long to wait between refreshing DNS resolutions of Alertmanager hosts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1m"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Refresh Interval"
+	RefreshInterval PrometheusDuration `json:"refreshInterval,omitempty"`
+}
+
+// AlertManagerNotificationQueueSpec defines the configuration for AlertManager notification settings.
+type AlertManagerNotificationQueueSpec struct {
+	// Capacity of the queue for notifications to be sent to the Alertmanager.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=10000
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Notification Queue Capacity"
+	Capacity int32 `json:"capacity,omitempty"`
+
+	// HTTP timeout duration when sending notifications to the Alertmanager.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="10s"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timeout"
+	Timeout PrometheusDuration `json:"timeout,omitempty"`
+
+	// Max time to tolerate outage for restoring "for" state of alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1h"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outage Tolerance"
+	ForOutageTolerance PrometheusDuration `json:"forOutageTolerance,omitempty"`
+
+	// Minimum duration between alert and restored "for" state. This is maintained
+	// only for alerts with configured "for" time greater than the grace period.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="10m"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Firing Grace Period"
+	ForGracePeriod PrometheusDuration `json:"forGracePeriod,omitempty"`
+
+	// Minimum amount of time to wait before resending an alert to Alertmanager.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1m"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resend Delay"
+	ResendDelay PrometheusDuration `json:"resendDelay,omitempty"`
+}
+
+// AlertManagerSpec defines the configuration for ruler's alertmanager connectivity.
+type AlertManagerSpec struct {
+	// URL for alerts return path.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Alert External URL"
+	ExternalURL string `json:"externalUrl,omitempty"`
+
+	// Additional labels to add to all alerts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Alert Labels"
+	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+
+	// List of AlertManager URLs to send notifications to. Each Alertmanager URL is treated as
+	// a separate group in the configuration. Multiple Alertmanagers in HA per group can be
+	// supported by using DNS resolution (See DiscoverySpec).
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="AlertManager Endpoints"
+	Endpoints []string `json:"endpoints"`
+
+	// Defines the configuration for DNS-based discovery of AlertManager hosts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DNS Discovery"
+	DiscoverySpec *AlertManagerDiscoverySpec `json:"discovery,omitempty"`
+
+	// Defines the configuration for the notification queue to AlertManager hosts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Notification Queue"
+	NotificationQueueSpec *AlertManagerNotificationQueueSpec `json:"notificationQueue,omitempty"`
+
+	// Client configuration for reaching the alertmanager endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Config"
+	Client *AlertManagerClientConfig `json:"client,omitempty"`
+}
+
+// AlertManagerClientConfig defines the client configuration for reaching alertmanager endpoints.
+type AlertManagerClientConfig struct {
+	// TLS configuration for reaching the alertmanager endpoints.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS"
+	TLS *AlertManagerClientTLSConfig `json:"tls,omitempty"`
+
+	// Header authentication configuration for reaching the alertmanager endpoints.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Header Authentication"
+	HeaderAuth *AlertManagerClientHeaderAuth `json:"headerAuth,omitempty"`
+
+	// Basic authentication configuration for reaching the alertmanager endpoints.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Basic Authentication"
+	BasicAuth *AlertManagerClientBasicAuth `json:"basicAuth,omitempty"`
+}
+
+// AlertManagerClientBasicAuth defines the basic authentication configuration for reaching alertmanager endpoints.
+type AlertManagerClientBasicAuth struct {
+	// The subject's username for the basic authentication configuration.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Username"
+	Username *string `json:"username,omitempty"`
+
+	// The secret key holding the subject's password for the basic authentication configuration.
+	// The secret must be in the namespace of the LokiStack. Not supported for tenant overrides.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Password"
+	Password *corev1.SecretKeySelector `json:"password,omitempty"`
+}
+
+// AlertManagerClientHeaderAuth defines the header configuration reaching alertmanager endpoints.
+type AlertManagerClientHeaderAuth struct {
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Type"
+	Type *string `json:"type,omitempty"`
+
+	// The secret key holding the credentials for the header authentication configuration.
+	// The secret must be in the namespace of the LokiStack.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Credentials"
+	Credentials *corev1.SecretKeySelector `json:"credentials,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Credentials File"
+	CredentialsFile *string `json:"credentialsFile,omitempty"`
+}
+
+// AlertManagerClientTLSConfig defines the TLS configuration for reaching alertmanager endpoints.
+type AlertManagerClientTLSConfig struct {
+	// The CA certificate file path for the TLS configuration.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CA Path"
+	CAPath *string `json:"caPath,omitempty"`
+
+	// The server name to validate in the alertmanager server certificates.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Server Name"
+	ServerName *string `json:"serverName,omitempty"`
+
+	// The client-side certificate file path for the TLS configuration.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cert Path"
+	CertPath *string `json:"certPath,omitempty"`
+
+	// The client-side key file path for the TLS configuration.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Path"
+	KeyPath *string `json:"keyPath,omitempty"`
+
+	// Skip validating server certificate.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Skip validating server certificate"
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// RulerOverrides defines the overrides applied per-tenant.
+type RulerOverrides struct {
+	// AlertManagerOverrides defines the overrides to apply to the alertmanager config.
+	// The external URL and external labels apply globally and are not overridable per tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="AlertManager Overrides"
+	AlertManagerOverrides *AlertManagerSpec `json:"alertmanager,omitempty"`
+}
+
+// RulerConfigSpec defines the desired state of RulerConfig
+type RulerConfigSpec struct {
+	// Interval on how frequently to evaluate rules.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1m"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Evaluation Interval"
+	EvaluationInterval PrometheusDuration `json:"evaluationInterval,omitempty"`
+
+	// Interval on how frequently to poll for new rule definitions.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1m"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Poll Interval"
+	PollInterval PrometheusDuration `json:"pollInterval,omitempty"`
+
+	// Defines alert manager configuration to notify on firing alerts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced",displayName="Alert Manager Configuration"
+	AlertManagerSpec *AlertManagerSpec `json:"alertmanager,omitempty"`
+
+	// Overrides defines the config overrides to be applied per-tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced",displayName="Overrides"
+	Overrides map[string]RulerOverrides `json:"overrides,omitempty"`
+}
+
+// RulerConfigStatus defines the observed state of RulerConfig
+type RulerConfigStatus struct {
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// RulerConfig is the Schema for the rulerconfigs API. It configures the ruler
+// of the LokiStack with the same name in the same namespace.
+type RulerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RulerConfigSpec   `json:"spec,omitempty"`
+	Status RulerConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RulerConfigList contains a list of RulerConfig
+type RulerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RulerConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RulerConfig{}, &RulerConfigList{})
+}
@@ -13,3 +13,8 @@ type StorageSchemaEffectiveDate string
 func (d StorageSchemaEffectiveDate) UTCTime() (time.Time, error) {
 	return time.Parse("2006-01-02", string(d))
 }
+
+// PrometheusDuration defines the type for Prometheus durations.
+//
+// +kubebuilder:validation:Pattern:="((([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?|0)"
+type PrometheusDuration string
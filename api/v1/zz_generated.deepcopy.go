@@ -27,383 +27,496 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackoffConfig) DeepCopyInto(out *BackoffConfig) {
+func (in *AlertManagerClientBasicAuth) DeepCopyInto(out *AlertManagerClientBasicAuth) {
 	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackoffConfig.
-func (in *BackoffConfig) DeepCopy() *BackoffConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerClientBasicAuth.
+func (in *AlertManagerClientBasicAuth) DeepCopy() *AlertManagerClientBasicAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(BackoffConfig)
+	out := new(AlertManagerClientBasicAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BasicAuthConfig) DeepCopyInto(out *BasicAuthConfig) {
+func (in *AlertManagerClientConfig) DeepCopyInto(out *AlertManagerClientConfig) {
 	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(AlertManagerClientTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeaderAuth != nil {
+		in, out := &in.HeaderAuth, &out.HeaderAuth
+		*out = new(AlertManagerClientHeaderAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(AlertManagerClientBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthConfig.
-func (in *BasicAuthConfig) DeepCopy() *BasicAuthConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerClientConfig.
+func (in *AlertManagerClientConfig) DeepCopy() *AlertManagerClientConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(BasicAuthConfig)
+	out := new(AlertManagerClientConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Canary) DeepCopyInto(out *Canary) {
+func (in *AlertManagerClientHeaderAuth) DeepCopyInto(out *AlertManagerClientHeaderAuth) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsFile != nil {
+		in, out := &in.CredentialsFile, &out.CredentialsFile
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
-func (in *Canary) DeepCopy() *Canary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerClientHeaderAuth.
+func (in *AlertManagerClientHeaderAuth) DeepCopy() *AlertManagerClientHeaderAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(Canary)
+	out := new(AlertManagerClientHeaderAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Canary) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CanaryList) DeepCopyInto(out *CanaryList) {
+func (in *AlertManagerClientTLSConfig) DeepCopyInto(out *AlertManagerClientTLSConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Canary, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CAPath != nil {
+		in, out := &in.CAPath, &out.CAPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerName != nil {
+		in, out := &in.ServerName, &out.ServerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertPath != nil {
+		in, out := &in.CertPath, &out.CertPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPath != nil {
+		in, out := &in.KeyPath, &out.KeyPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.InsecureSkipVerify != nil {
+		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryList.
-func (in *CanaryList) DeepCopy() *CanaryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerClientTLSConfig.
+func (in *AlertManagerClientTLSConfig) DeepCopy() *AlertManagerClientTLSConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CanaryList)
+	out := new(AlertManagerClientTLSConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CanaryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CanarySpec) DeepCopyInto(out *CanarySpec) {
+func (in *AlertManagerDiscoverySpec) DeepCopyInto(out *AlertManagerDiscoverySpec) {
 	*out = *in
-	if in.DaemonSetLabels != nil {
-		in, out := &in.DaemonSetLabels, &out.DaemonSetLabels
-		*out = make([]Label, len(*in))
-		copy(*out, *in)
-	}
-	if in.PodLabels != nil {
-		in, out := &in.PodLabels, &out.PodLabels
-		*out = make([]Label, len(*in))
-		copy(*out, *in)
-	}
-	if in.PodAnnotations != nil {
-		in, out := &in.PodAnnotations, &out.PodAnnotations
-		*out = make([]Label, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySpec.
-func (in *CanarySpec) DeepCopy() *CanarySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerDiscoverySpec.
+func (in *AlertManagerDiscoverySpec) DeepCopy() *AlertManagerDiscoverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CanarySpec)
+	out := new(AlertManagerDiscoverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+func (in *AlertManagerNotificationQueueSpec) DeepCopyInto(out *AlertManagerNotificationQueueSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
-func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerNotificationQueueSpec.
+func (in *AlertManagerNotificationQueueSpec) DeepCopy() *AlertManagerNotificationQueueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CanaryStatus)
+	out := new(AlertManagerNotificationQueueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientConfig) DeepCopyInto(out *ClientConfig) {
+func (in *AlertManagerSpec) DeepCopyInto(out *AlertManagerSpec) {
 	*out = *in
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.BasicAuth != nil {
-		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(BasicAuthConfig)
-		**out = **in
-	}
-	if in.OAuth2 != nil {
-		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(OAuth2Config)
-		(*in).DeepCopyInto(*out)
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.TLSConfig != nil {
-		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(TLSConfig)
+	if in.DiscoverySpec != nil {
+		in, out := &in.DiscoverySpec, &out.DiscoverySpec
+		*out = new(AlertManagerDiscoverySpec)
 		**out = **in
 	}
-	if in.BackoffConfig != nil {
-		in, out := &in.BackoffConfig, &out.BackoffConfig
-		*out = new(BackoffConfig)
+	if in.NotificationQueueSpec != nil {
+		in, out := &in.NotificationQueueSpec, &out.NotificationQueueSpec
+		*out = new(AlertManagerNotificationQueueSpec)
 		**out = **in
 	}
-	if in.ExternalLabels != nil {
-		in, out := &in.ExternalLabels, &out.ExternalLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Client != nil {
+		in, out := &in.Client, &out.Client
+		*out = new(AlertManagerClientConfig)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfig.
-func (in *ClientConfig) DeepCopy() *ClientConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerSpec.
+func (in *AlertManagerSpec) DeepCopy() *AlertManagerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientConfig)
+	out := new(AlertManagerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterProxy) DeepCopyInto(out *ClusterProxy) {
+func (in *AlertingRule) DeepCopyInto(out *AlertingRule) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProxy.
-func (in *ClusterProxy) DeepCopy() *ClusterProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRule.
+func (in *AlertingRule) DeepCopy() *AlertingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterProxy)
+	out := new(AlertingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EmptyStruct) DeepCopyInto(out *EmptyStruct) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyStruct.
-func (in *EmptyStruct) DeepCopy() *EmptyStruct {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertingRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(EmptyStruct)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HashRingSpec) DeepCopyInto(out *HashRingSpec) {
+func (in *AlertingRuleGroup) DeepCopyInto(out *AlertingRuleGroup) {
 	*out = *in
-	if in.MemberList != nil {
-		in, out := &in.MemberList, &out.MemberList
-		*out = new(MemberListSpec)
-		**out = **in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*AlertingRuleGroupSpec, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlertingRuleGroupSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashRingSpec.
-func (in *HashRingSpec) DeepCopy() *HashRingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRuleGroup.
+func (in *AlertingRuleGroup) DeepCopy() *AlertingRuleGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(HashRingSpec)
+	out := new(AlertingRuleGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngestionLimitSpec) DeepCopyInto(out *IngestionLimitSpec) {
+func (in *AlertingRuleGroupSpec) DeepCopyInto(out *AlertingRuleGroupSpec) {
 	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngestionLimitSpec.
-func (in *IngestionLimitSpec) DeepCopy() *IngestionLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRuleGroupSpec.
+func (in *AlertingRuleGroupSpec) DeepCopy() *AlertingRuleGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngestionLimitSpec)
+	out := new(AlertingRuleGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
+func (in *AlertingRuleList) DeepCopyInto(out *AlertingRuleList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSDConfig.
-func (in *KubernetesSDConfig) DeepCopy() *KubernetesSDConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRuleList.
+func (in *AlertingRuleList) DeepCopy() *AlertingRuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSDConfig)
+	out := new(AlertingRuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertingRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Label) DeepCopyInto(out *Label) {
+func (in *AlertingRuleSpec) DeepCopyInto(out *AlertingRuleSpec) {
 	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*AlertingRuleGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlertingRuleGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
-func (in *Label) DeepCopy() *Label {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRuleSpec.
+func (in *AlertingRuleSpec) DeepCopy() *AlertingRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Label)
+	out := new(AlertingRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LimitsSpec) DeepCopyInto(out *LimitsSpec) {
+func (in *AlertingRuleStatus) DeepCopyInto(out *AlertingRuleStatus) {
 	*out = *in
-	if in.Global != nil {
-		in, out := &in.Global, &out.Global
-		*out = new(LimitsTemplateSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitsSpec.
-func (in *LimitsSpec) DeepCopy() *LimitsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingRuleStatus.
+func (in *AlertingRuleStatus) DeepCopy() *AlertingRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LimitsSpec)
+	out := new(AlertingRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LimitsTemplateSpec) DeepCopyInto(out *LimitsTemplateSpec) {
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
-	if in.IngestionLimits != nil {
-		in, out := &in.IngestionLimits, &out.IngestionLimits
-		*out = new(IngestionLimitSpec)
-		**out = **in
-	}
-	if in.QueryLimits != nil {
-		in, out := &in.QueryLimits, &out.QueryLimits
-		*out = new(QueryLimitSpec)
-		**out = **in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitsTemplateSpec.
-func (in *LimitsTemplateSpec) DeepCopy() *LimitsTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LimitsTemplateSpec)
+	out := new(AuthenticationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiComponentSpec) DeepCopyInto(out *LokiComponentSpec) {
+func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
 	*out = *in
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]corev1.Toleration, len(*in))
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]RoleBindingsSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PodAntiAffinity != nil {
-		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
-		*out = new(corev1.PodAntiAffinity)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiComponentSpec.
-func (in *LokiComponentSpec) DeepCopy() *LokiComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
+func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiComponentSpec)
+	out := new(AuthorizationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStack) DeepCopyInto(out *LokiStack) {
+func (in *BackoffConfig) DeepCopyInto(out *BackoffConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStack.
-func (in *LokiStack) DeepCopy() *LokiStack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackoffConfig.
+func (in *BackoffConfig) DeepCopy() *BackoffConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStack)
+	out := new(BackoffConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAuthConfig) DeepCopyInto(out *BasicAuthConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAuthConfig.
+func (in *BasicAuthConfig) DeepCopy() *BasicAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedQuerySpec) DeepCopyInto(out *BlockedQuerySpec) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make(BlockedQueryTypes, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedQuerySpec.
+func (in *BlockedQuerySpec) DeepCopy() *BlockedQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in BlockedQueryTypes) DeepCopyInto(out *BlockedQueryTypes) {
+	{
+		in := &in
+		*out = make(BlockedQueryTypes, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedQueryTypes.
+func (in BlockedQueryTypes) DeepCopy() BlockedQueryTypes {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedQueryTypes)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Canary) DeepCopyInto(out *Canary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
+func (in *Canary) DeepCopy() *Canary {
+	if in == nil {
+		return nil
+	}
+	out := new(Canary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LokiStack) DeepCopyObject() runtime.Object {
+func (in *Canary) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -411,110 +524,607 @@ func (in *LokiStack) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackComponentStatus) DeepCopyInto(out *LokiStackComponentStatus) {
+func (in *CanaryList) DeepCopyInto(out *CanaryList) {
 	*out = *in
-	if in.Compactor != nil {
-		in, out := &in.Compactor, &out.Compactor
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Canary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Distributor != nil {
-		in, out := &in.Distributor, &out.Distributor
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryList.
+func (in *CanaryList) DeepCopy() *CanaryList {
+	if in == nil {
+		return nil
 	}
-	if in.IndexGateway != nil {
-		in, out := &in.IndexGateway, &out.IndexGateway
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	out := new(CanaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Ingester != nil {
-		in, out := &in.Ingester, &out.Ingester
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanarySpec) DeepCopyInto(out *CanarySpec) {
+	*out = *in
+	if in.DaemonSetLabels != nil {
+		in, out := &in.DaemonSetLabels, &out.DaemonSetLabels
+		*out = make([]Label, len(*in))
+		copy(*out, *in)
 	}
-	if in.Querier != nil {
-		in, out := &in.Querier, &out.Querier
-		*out = make(PodStatusMap, len(*in))
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make([]Label, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make([]Label, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySpec.
+func (in *CanarySpec) DeepCopy() *CanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfig) DeepCopyInto(out *ClientConfig) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
+			(*out)[key] = val
 		}
 	}
-	if in.QueryFrontend != nil {
-		in, out := &in.QueryFrontend, &out.QueryFrontend
-		*out = make(PodStatusMap, len(*in))
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuthConfig)
+		**out = **in
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.BackoffConfig != nil {
+		in, out := &in.BackoffConfig, &out.BackoffConfig
+		*out = new(BackoffConfig)
+		**out = **in
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
+			(*out)[key] = val
 		}
 	}
-	if in.Gateway != nil {
-		in, out := &in.Gateway, &out.Gateway
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfig.
+func (in *ClientConfig) DeepCopy() *ClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProxy) DeepCopyInto(out *ClusterProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProxy.
+func (in *ClusterProxy) DeepCopy() *ClusterProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyStruct) DeepCopyInto(out *EmptyStruct) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyStruct.
+func (in *EmptyStruct) DeepCopy() *EmptyStruct {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyStruct)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashRingSpec) DeepCopyInto(out *HashRingSpec) {
+	*out = *in
+	if in.MemberList != nil {
+		in, out := &in.MemberList, &out.MemberList
+		*out = new(MemberListSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashRingSpec.
+func (in *HashRingSpec) DeepCopy() *HashRingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HashRingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngesterSpec) DeepCopyInto(out *IngesterSpec) {
+	*out = *in
+	if in.WAL != nil {
+		in, out := &in.WAL, &out.WAL
+		*out = new(IngesterWALSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngesterSpec.
+func (in *IngesterSpec) DeepCopy() *IngesterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngesterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngesterWALSpec) DeepCopyInto(out *IngesterWALSpec) {
+	*out = *in
+	if in.ReplayMemoryCeiling != nil {
+		in, out := &in.ReplayMemoryCeiling, &out.ReplayMemoryCeiling
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngesterWALSpec.
+func (in *IngesterWALSpec) DeepCopy() *IngesterWALSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngesterWALSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngestionLimitSpec) DeepCopyInto(out *IngestionLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngestionLimitSpec.
+func (in *IngestionLimitSpec) DeepCopy() *IngestionLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngestionLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSDConfig.
+func (in *KubernetesSDConfig) DeepCopy() *KubernetesSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Label) DeepCopyInto(out *Label) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Label.
+func (in *Label) DeepCopy() *Label {
+	if in == nil {
+		return nil
+	}
+	out := new(Label)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitsSpec) DeepCopyInto(out *LimitsSpec) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(LimitsTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenants != nil {
+		in, out := &in.Tenants, &out.Tenants
+		*out = make(map[string]PerTenantLimitsTemplateSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitsSpec.
+func (in *LimitsSpec) DeepCopy() *LimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitsTemplateSpec) DeepCopyInto(out *LimitsTemplateSpec) {
+	*out = *in
+	if in.IngestionLimits != nil {
+		in, out := &in.IngestionLimits, &out.IngestionLimits
+		*out = new(IngestionLimitSpec)
+		**out = **in
+	}
+	if in.QueryLimits != nil {
+		in, out := &in.QueryLimits, &out.QueryLimits
+		*out = new(QueryLimitSpec)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitsTemplateSpec.
+func (in *LimitsTemplateSpec) DeepCopy() *LimitsTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitsTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeletionRequest) DeepCopyInto(out *LogDeletionRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeletionRequest.
+func (in *LogDeletionRequest) DeepCopy() *LogDeletionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeletionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogDeletionRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeletionRequestList) DeepCopyInto(out *LogDeletionRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogDeletionRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeletionRequestList.
+func (in *LogDeletionRequestList) DeepCopy() *LogDeletionRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeletionRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogDeletionRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeletionRequestSpec) DeepCopyInto(out *LogDeletionRequestSpec) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeletionRequestSpec.
+func (in *LogDeletionRequestSpec) DeepCopy() *LogDeletionRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeletionRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeletionRequestStatus) DeepCopyInto(out *LogDeletionRequestStatus) {
+	*out = *in
+	if in.SubmittedAt != nil {
+		in, out := &in.SubmittedAt, &out.SubmittedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeletionRequestStatus.
+func (in *LogDeletionRequestStatus) DeepCopy() *LogDeletionRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeletionRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiComponentSpec) DeepCopyInto(out *LokiComponentSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAntiAffinity != nil {
+		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
+		*out = new(corev1.PodAntiAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiComponentSpec.
+func (in *LokiComponentSpec) DeepCopy() *LokiComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStack) DeepCopyInto(out *LokiStack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStack.
+func (in *LokiStack) DeepCopy() *LokiStack {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LokiStack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackComponentStatus) DeepCopyInto(out *LokiStackComponentStatus) {
+	*out = *in
+	if in.Compactor != nil {
+		in, out := &in.Compactor, &out.Compactor
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Distributor != nil {
+		in, out := &in.Distributor, &out.Distributor
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.IndexGateway != nil {
+		in, out := &in.IndexGateway, &out.IndexGateway
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Ingester != nil {
+		in, out := &in.Ingester, &out.Ingester
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Querier != nil {
+		in, out := &in.Querier, &out.Querier
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.QueryFrontend != nil {
+		in, out := &in.QueryFrontend, &out.QueryFrontend
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Ruler != nil {
+		in, out := &in.Ruler, &out.Ruler
+		*out = make(PodStatusMap, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
 				(*out)[key] = nil
 			} else {
 				inVal := (*in)[key]
@@ -525,8 +1135,420 @@ func (in *LokiStackComponentStatus) DeepCopyInto(out *LokiStackComponentStatus)
 			(*out)[key] = outVal
 		}
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackComponentStatus.
+func (in *LokiStackComponentStatus) DeepCopy() *LokiStackComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackList) DeepCopyInto(out *LokiStackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LokiStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackList.
+func (in *LokiStackList) DeepCopy() *LokiStackList {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LokiStackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackSpec) DeepCopyInto(out *LokiStackSpec) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(LokiTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(LimitsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ClusterProxy)
+		**out = **in
+	}
+	if in.HashRing != nil {
+		in, out := &in.HashRing, &out.HashRing
+		*out = new(HashRingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingester != nil {
+		in, out := &in.Ingester, &out.Ingester
+		*out = new(IngesterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenants != nil {
+		in, out := &in.Tenants, &out.Tenants
+		*out = new(TenantsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = new(RulesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackSpec.
+func (in *LokiStackSpec) DeepCopy() *LokiStackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackStatus) DeepCopyInto(out *LokiStackStatus) {
+	*out = *in
+	in.Components.DeepCopyInto(&out.Components)
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackStatus.
+func (in *LokiStackStatus) DeepCopy() *LokiStackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackStorageStatus) DeepCopyInto(out *LokiStackStorageStatus) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]ObjectStorageSchema, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackStorageStatus.
+func (in *LokiStackStorageStatus) DeepCopy() *LokiStackStorageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackStorageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiTemplateSpec) DeepCopyInto(out *LokiTemplateSpec) {
+	*out = *in
+	if in.Compactor != nil {
+		in, out := &in.Compactor, &out.Compactor
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Distributor != nil {
+		in, out := &in.Distributor, &out.Distributor
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingester != nil {
+		in, out := &in.Ingester, &out.Ingester
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Querier != nil {
+		in, out := &in.Querier, &out.Querier
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryFrontend != nil {
+		in, out := &in.QueryFrontend, &out.QueryFrontend
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IndexGateway != nil {
+		in, out := &in.IndexGateway, &out.IndexGateway
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ruler != nil {
 		in, out := &in.Ruler, &out.Ruler
+		*out = new(LokiComponentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiTemplateSpec.
+func (in *LokiTemplateSpec) DeepCopy() *LokiTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberListSpec) DeepCopyInto(out *MemberListSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberListSpec.
+func (in *MemberListSpec) DeepCopy() *MemberListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2Config) DeepCopyInto(out *OAuth2Config) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointParams != nil {
+		in, out := &in.EndpointParams, &out.EndpointParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Config.
+func (in *OAuth2Config) DeepCopy() *OAuth2Config {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCSpec) DeepCopyInto(out *OIDCSpec) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(TenantSecretSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSpec.
+func (in *OIDCSpec) DeepCopy() *OIDCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageSchema) DeepCopyInto(out *ObjectStorageSchema) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSchema.
+func (in *ObjectStorageSchema) DeepCopy() *ObjectStorageSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageSecretSpec) DeepCopyInto(out *ObjectStorageSecretSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSecretSpec.
+func (in *ObjectStorageSecretSpec) DeepCopy() *ObjectStorageSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageSpec) DeepCopyInto(out *ObjectStorageSpec) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]ObjectStorageSchema, len(*in))
+		copy(*out, *in)
+	}
+	out.Secret = in.Secret
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ObjectStorageTLSSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSpec.
+func (in *ObjectStorageSpec) DeepCopy() *ObjectStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageTLSSpec) DeepCopyInto(out *ObjectStorageTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageTLSSpec.
+func (in *ObjectStorageTLSSpec) DeepCopy() *ObjectStorageTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerTenantLimitsTemplateSpec) DeepCopyInto(out *PerTenantLimitsTemplateSpec) {
+	*out = *in
+	if in.IngestionLimits != nil {
+		in, out := &in.IngestionLimits, &out.IngestionLimits
+		*out = new(IngestionLimitSpec)
+		**out = **in
+	}
+	if in.QueryLimits != nil {
+		in, out := &in.QueryLimits, &out.QueryLimits
+		*out = new(PerTenantQueryLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerTenantLimitsTemplateSpec.
+func (in *PerTenantLimitsTemplateSpec) DeepCopy() *PerTenantLimitsTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PerTenantLimitsTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerTenantQueryLimitSpec) DeepCopyInto(out *PerTenantQueryLimitSpec) {
+	*out = *in
+	out.QueryLimitSpec = in.QueryLimitSpec
+	if in.Blocked != nil {
+		in, out := &in.Blocked, &out.Blocked
+		*out = make([]BlockedQuerySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerTenantQueryLimitSpec.
+func (in *PerTenantQueryLimitSpec) DeepCopy() *PerTenantQueryLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PerTenantQueryLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineStage) DeepCopyInto(out *PipelineStage) {
+	*out = *in
+	if in.Docker != nil {
+		in, out := &in.Docker, &out.Docker
+		*out = new(EmptyStruct)
+		**out = **in
+	}
+	if in.Cri != nil {
+		in, out := &in.Cri, &out.Cri
+		*out = new(EmptyStruct)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStage.
+func (in *PipelineStage) DeepCopy() *PipelineStage {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PodStatusMap) DeepCopyInto(out *PodStatusMap) {
+	{
+		in := &in
 		*out = make(PodStatusMap, len(*in))
 		for key, val := range *in {
 			var outVal []string
@@ -543,42 +1565,116 @@ func (in *LokiStackComponentStatus) DeepCopyInto(out *LokiStackComponentStatus)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackComponentStatus.
-func (in *LokiStackComponentStatus) DeepCopy() *LokiStackComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatusMap.
+func (in PodStatusMap) DeepCopy() PodStatusMap {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackComponentStatus)
+	out := new(PodStatusMap)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PositionsConfig) DeepCopyInto(out *PositionsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PositionsConfig.
+func (in *PositionsConfig) DeepCopy() *PositionsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PositionsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackList) DeepCopyInto(out *LokiStackList) {
+func (in *Promtail) DeepCopyInto(out *Promtail) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promtail.
+func (in *Promtail) DeepCopy() *Promtail {
+	if in == nil {
+		return nil
+	}
+	out := new(Promtail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Promtail) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromtailConfig) DeepCopyInto(out *PromtailConfig) {
+	*out = *in
+	out.Server = in.Server
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]ClientConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Positions = in.Positions
+	out.TargetConfig = in.TargetConfig
+	if in.ScrapeConfigs != nil {
+		in, out := &in.ScrapeConfigs, &out.ScrapeConfigs
+		*out = make([]ScrapeConfigsConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailConfig.
+func (in *PromtailConfig) DeepCopy() *PromtailConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PromtailConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromtailList) DeepCopyInto(out *PromtailList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LokiStack, len(*in))
+		*out = make([]Promtail, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackList.
-func (in *LokiStackList) DeepCopy() *LokiStackList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailList.
+func (in *PromtailList) DeepCopy() *PromtailList {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackList)
+	out := new(PromtailList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LokiStackList) DeepCopyObject() runtime.Object {
+func (in *PromtailList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -586,170 +1682,224 @@ func (in *LokiStackList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackSpec) DeepCopyInto(out *LokiStackSpec) {
+func (in *PromtailSpec) DeepCopyInto(out *PromtailSpec) {
 	*out = *in
-	in.Storage.DeepCopyInto(&out.Storage)
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(LokiTemplateSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = new(LimitsSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Proxy != nil {
-		in, out := &in.Proxy, &out.Proxy
-		*out = new(ClusterProxy)
-		**out = **in
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailSpec.
+func (in *PromtailSpec) DeepCopy() *PromtailSpec {
+	if in == nil {
+		return nil
 	}
-	if in.HashRing != nil {
-		in, out := &in.HashRing, &out.HashRing
-		*out = new(HashRingSpec)
-		(*in).DeepCopyInto(*out)
+	out := new(PromtailSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromtailStatus) DeepCopyInto(out *PromtailStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailStatus.
+func (in *PromtailStatus) DeepCopy() *PromtailStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Replication != nil {
-		in, out := &in.Replication, &out.Replication
-		*out = new(ReplicationSpec)
-		(*in).DeepCopyInto(*out)
+	out := new(PromtailStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryLimitSpec) DeepCopyInto(out *QueryLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLimitSpec.
+func (in *QueryLimitSpec) DeepCopy() *QueryLimitSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(QueryLimitSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackSpec.
-func (in *LokiStackSpec) DeepCopy() *LokiStackSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordingRule) DeepCopyInto(out *RecordingRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRule.
+func (in *RecordingRule) DeepCopy() *RecordingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackSpec)
+	out := new(RecordingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecordingRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackStatus) DeepCopyInto(out *LokiStackStatus) {
+func (in *RecordingRuleGroup) DeepCopyInto(out *RecordingRuleGroup) {
 	*out = *in
-	in.Components.DeepCopyInto(&out.Components)
-	in.Storage.DeepCopyInto(&out.Storage)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*RecordingRuleGroupSpec, len(*in))
 		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RecordingRuleGroupSpec)
+				(*in).DeepCopyInto(*out)
+			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackStatus.
-func (in *LokiStackStatus) DeepCopy() *LokiStackStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleGroup.
+func (in *RecordingRuleGroup) DeepCopy() *RecordingRuleGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackStatus)
+	out := new(RecordingRuleGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackStorageStatus) DeepCopyInto(out *LokiStackStorageStatus) {
+func (in *RecordingRuleGroupSpec) DeepCopyInto(out *RecordingRuleGroupSpec) {
 	*out = *in
-	if in.Schemas != nil {
-		in, out := &in.Schemas, &out.Schemas
-		*out = make([]ObjectStorageSchema, len(*in))
-		copy(*out, *in)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackStorageStatus.
-func (in *LokiStackStorageStatus) DeepCopy() *LokiStackStorageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleGroupSpec.
+func (in *RecordingRuleGroupSpec) DeepCopy() *RecordingRuleGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackStorageStatus)
+	out := new(RecordingRuleGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiTemplateSpec) DeepCopyInto(out *LokiTemplateSpec) {
+func (in *RecordingRuleList) DeepCopyInto(out *RecordingRuleList) {
 	*out = *in
-	if in.Compactor != nil {
-		in, out := &in.Compactor, &out.Compactor
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Distributor != nil {
-		in, out := &in.Distributor, &out.Distributor
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ingester != nil {
-		in, out := &in.Ingester, &out.Ingester
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Querier != nil {
-		in, out := &in.Querier, &out.Querier
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueryFrontend != nil {
-		in, out := &in.QueryFrontend, &out.QueryFrontend
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RecordingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Gateway != nil {
-		in, out := &in.Gateway, &out.Gateway
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleList.
+func (in *RecordingRuleList) DeepCopy() *RecordingRuleList {
+	if in == nil {
+		return nil
 	}
-	if in.IndexGateway != nil {
-		in, out := &in.IndexGateway, &out.IndexGateway
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
+	out := new(RecordingRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecordingRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Ruler != nil {
-		in, out := &in.Ruler, &out.Ruler
-		*out = new(LokiComponentSpec)
-		(*in).DeepCopyInto(*out)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordingRuleSpec) DeepCopyInto(out *RecordingRuleSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*RecordingRuleGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RecordingRuleGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiTemplateSpec.
-func (in *LokiTemplateSpec) DeepCopy() *LokiTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleSpec.
+func (in *RecordingRuleSpec) DeepCopy() *RecordingRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiTemplateSpec)
+	out := new(RecordingRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberListSpec) DeepCopyInto(out *MemberListSpec) {
+func (in *RecordingRuleStatus) DeepCopyInto(out *RecordingRuleStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberListSpec.
-func (in *MemberListSpec) DeepCopy() *MemberListSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingRuleStatus.
+func (in *RecordingRuleStatus) DeepCopy() *RecordingRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberListSpec)
+	out := new(RecordingRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OAuth2Config) DeepCopyInto(out *OAuth2Config) {
+func (in *RelabelConfigsConfig) DeepCopyInto(out *RelabelConfigsConfig) {
 	*out = *in
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.EndpointParams != nil {
-		in, out := &in.EndpointParams, &out.EndpointParams
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelabelConfigsConfig.
+func (in *RelabelConfigsConfig) DeepCopy() *RelabelConfigsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RelabelConfigsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWriteClientSpec) DeepCopyInto(out *RemoteWriteClientSpec) {
+	*out = *in
+	if in.AdditionalHeaders != nil {
+		in, out := &in.AdditionalHeaders, &out.AdditionalHeaders
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
@@ -757,139 +1907,156 @@ func (in *OAuth2Config) DeepCopyInto(out *OAuth2Config) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Config.
-func (in *OAuth2Config) DeepCopy() *OAuth2Config {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteClientSpec.
+func (in *RemoteWriteClientSpec) DeepCopy() *RemoteWriteClientSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OAuth2Config)
+	out := new(RemoteWriteClientSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStorageSchema) DeepCopyInto(out *ObjectStorageSchema) {
+func (in *RemoteWriteSpec) DeepCopyInto(out *RemoteWriteSpec) {
 	*out = *in
+	if in.Client != nil {
+		in, out := &in.Client, &out.Client
+		*out = new(RemoteWriteClientSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSchema.
-func (in *ObjectStorageSchema) DeepCopy() *ObjectStorageSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWriteSpec.
+func (in *RemoteWriteSpec) DeepCopy() *RemoteWriteSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStorageSchema)
+	out := new(RemoteWriteSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStorageSecretSpec) DeepCopyInto(out *ObjectStorageSecretSpec) {
+func (in *ReplicationSpec) DeepCopyInto(out *ReplicationSpec) {
 	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSecretSpec.
-func (in *ObjectStorageSecretSpec) DeepCopy() *ObjectStorageSecretSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSpec.
+func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStorageSecretSpec)
+	out := new(ReplicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectStorageSpec) DeepCopyInto(out *ObjectStorageSpec) {
+func (in *RetentionLimitSpec) DeepCopyInto(out *RetentionLimitSpec) {
 	*out = *in
-	if in.Schemas != nil {
-		in, out := &in.Schemas, &out.Schemas
-		*out = make([]ObjectStorageSchema, len(*in))
-		copy(*out, *in)
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]*RetentionStreamSpec, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RetentionStreamSpec)
+				**out = **in
+			}
+		}
 	}
-	out.Secret = in.Secret
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSpec.
-func (in *ObjectStorageSpec) DeepCopy() *ObjectStorageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionLimitSpec.
+func (in *RetentionLimitSpec) DeepCopy() *RetentionLimitSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectStorageSpec)
+	out := new(RetentionLimitSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineStage) DeepCopyInto(out *PipelineStage) {
+func (in *RetentionStreamSpec) DeepCopyInto(out *RetentionStreamSpec) {
 	*out = *in
-	if in.Docker != nil {
-		in, out := &in.Docker, &out.Docker
-		*out = new(EmptyStruct)
-		**out = **in
-	}
-	if in.Cri != nil {
-		in, out := &in.Cri, &out.Cri
-		*out = new(EmptyStruct)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStage.
-func (in *PipelineStage) DeepCopy() *PipelineStage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionStreamSpec.
+func (in *RetentionStreamSpec) DeepCopy() *RetentionStreamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineStage)
+	out := new(RetentionStreamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PodStatusMap) DeepCopyInto(out *PodStatusMap) {
-	{
-		in := &in
-		*out = make(PodStatusMap, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+func (in *RoleBindingsSpec) DeepCopyInto(out *RoleBindingsSpec) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatusMap.
-func (in PodStatusMap) DeepCopy() PodStatusMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBindingsSpec.
+func (in *RoleBindingsSpec) DeepCopy() *RoleBindingsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PodStatusMap)
+	out := new(RoleBindingsSpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PositionsConfig) DeepCopyInto(out *PositionsConfig) {
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tenants != nil {
+		in, out := &in.Tenants, &out.Tenants
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]PermissionType, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PositionsConfig.
-func (in *PositionsConfig) DeepCopy() *PositionsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PositionsConfig)
+	out := new(RoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Promtail) DeepCopyInto(out *Promtail) {
+func (in *RulerConfig) DeepCopyInto(out *RulerConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -897,18 +2064,18 @@ func (in *Promtail) DeepCopyInto(out *Promtail) {
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promtail.
-func (in *Promtail) DeepCopy() *Promtail {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulerConfig.
+func (in *RulerConfig) DeepCopy() *RulerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Promtail)
+	out := new(RulerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Promtail) DeepCopyObject() runtime.Object {
+func (in *RulerConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -916,63 +2083,31 @@ func (in *Promtail) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromtailConfig) DeepCopyInto(out *PromtailConfig) {
-	*out = *in
-	out.Server = in.Server
-	if in.Clients != nil {
-		in, out := &in.Clients, &out.Clients
-		*out = make([]ClientConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.Positions = in.Positions
-	out.TargetConfig = in.TargetConfig
-	if in.ScrapeConfigs != nil {
-		in, out := &in.ScrapeConfigs, &out.ScrapeConfigs
-		*out = make([]ScrapeConfigsConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailConfig.
-func (in *PromtailConfig) DeepCopy() *PromtailConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(PromtailConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromtailList) DeepCopyInto(out *PromtailList) {
+func (in *RulerConfigList) DeepCopyInto(out *RulerConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Promtail, len(*in))
+		*out = make([]RulerConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailList.
-func (in *PromtailList) DeepCopy() *PromtailList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulerConfigList.
+func (in *RulerConfigList) DeepCopy() *RulerConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(PromtailList)
+	out := new(RulerConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PromtailList) DeepCopyObject() runtime.Object {
+func (in *RulerConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -980,87 +2115,93 @@ func (in *PromtailList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromtailSpec) DeepCopyInto(out *PromtailSpec) {
+func (in *RulerConfigSpec) DeepCopyInto(out *RulerConfigSpec) {
 	*out = *in
-	in.Config.DeepCopyInto(&out.Config)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailSpec.
-func (in *PromtailSpec) DeepCopy() *PromtailSpec {
-	if in == nil {
-		return nil
+	if in.AlertManagerSpec != nil {
+		in, out := &in.AlertManagerSpec, &out.AlertManagerSpec
+		*out = new(AlertManagerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make(map[string]RulerOverrides, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	out := new(PromtailSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromtailStatus) DeepCopyInto(out *PromtailStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromtailStatus.
-func (in *PromtailStatus) DeepCopy() *PromtailStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulerConfigSpec.
+func (in *RulerConfigSpec) DeepCopy() *RulerConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PromtailStatus)
+	out := new(RulerConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryLimitSpec) DeepCopyInto(out *QueryLimitSpec) {
+func (in *RulerConfigStatus) DeepCopyInto(out *RulerConfigStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLimitSpec.
-func (in *QueryLimitSpec) DeepCopy() *QueryLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulerConfigStatus.
+func (in *RulerConfigStatus) DeepCopy() *RulerConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryLimitSpec)
+	out := new(RulerConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RelabelConfigsConfig) DeepCopyInto(out *RelabelConfigsConfig) {
+func (in *RulerOverrides) DeepCopyInto(out *RulerOverrides) {
 	*out = *in
-	if in.SourceLabels != nil {
-		in, out := &in.SourceLabels, &out.SourceLabels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.AlertManagerOverrides != nil {
+		in, out := &in.AlertManagerOverrides, &out.AlertManagerOverrides
+		*out = new(AlertManagerSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelabelConfigsConfig.
-func (in *RelabelConfigsConfig) DeepCopy() *RelabelConfigsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulerOverrides.
+func (in *RulerOverrides) DeepCopy() *RulerOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(RelabelConfigsConfig)
+	out := new(RulerOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSpec) DeepCopyInto(out *ReplicationSpec) {
+func (in *RulesSpec) DeepCopyInto(out *RulesSpec) {
 	*out = *in
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]ZoneSpec, len(*in))
-		copy(*out, *in)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = new(RemoteWriteSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSpec.
-func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesSpec.
+func (in *RulesSpec) DeepCopy() *RulesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSpec)
+	out := new(RulesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1114,6 +2255,21 @@ func (in *ServerConfig) DeepCopy() *ServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subject) DeepCopyInto(out *Subject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subject.
+func (in *Subject) DeepCopy() *Subject {
+	if in == nil {
+		return nil
+	}
+	out := new(Subject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 	*out = *in
@@ -1144,9 +2300,56 @@ func (in *TargetConfigConfig) DeepCopy() *TargetConfigConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantSecretSpec) DeepCopyInto(out *TenantSecretSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantSecretSpec.
+func (in *TenantSecretSpec) DeepCopy() *TenantSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantsSpec) DeepCopyInto(out *TenantsSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = make([]AuthenticationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantsSpec.
+func (in *TenantsSpec) DeepCopy() *TenantsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSpec.
@@ -140,6 +140,46 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Promtail")
 		os.Exit(1)
 	}
+	if err = (&controller.AlertingRuleReconciler{
+		Client: mgr.GetClient(),
+		Log:    logger.WithName("controllers").WithName("alertingrule"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertingRule")
+		os.Exit(1)
+	}
+	if err = (&controller.RecordingRuleReconciler{
+		Client: mgr.GetClient(),
+		Log:    logger.WithName("controllers").WithName("recordingrule"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RecordingRule")
+		os.Exit(1)
+	}
+	if err = (&controller.RulerConfigReconciler{
+		Client: mgr.GetClient(),
+		Log:    logger.WithName("controllers").WithName("rulerconfig"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RulerConfig")
+		os.Exit(1)
+	}
+	if err = (&controller.LogDeletionRequestReconciler{
+		Client: mgr.GetClient(),
+		Log:    logger.WithName("controllers").WithName("logdeletionrequest"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LogDeletionRequest")
+		os.Exit(1)
+	}
+	if err = (&controller.LokiStackZoneAwarePodReconciler{
+		Client: mgr.GetClient(),
+		Log:    logger.WithName("controllers").WithName("zoneawarepod"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LokiStackZoneAwarePod")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err = (&controller.CanaryReconciler{
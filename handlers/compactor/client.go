@@ -0,0 +1,149 @@
+package compactor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+)
+
+const (
+	deletePath   = "/loki/api/v1/delete"
+	tenantHeader = "X-Scope-OrgID"
+
+	// DeleteRequestReceived is the status of a delete request waiting to be processed.
+	DeleteRequestReceived = "received"
+	// DeleteRequestProcessed is the status of a delete request after the compactor processed it.
+	DeleteRequestProcessed = "processed"
+)
+
+// DeleteRequest is a delete request as returned by the compactor delete API.
+type DeleteRequest struct {
+	RequestID string  `json:"request_id"`
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	Query     string  `json:"query"`
+	Status    string  `json:"status"`
+	CreatedAt float64 `json:"created_at"`
+}
+
+// StatusError is returned for non-successful responses of the compactor delete API.
+type StatusError struct {
+	Op       string
+	Code     int
+	Response string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %d %s", e.Op, e.Code, e.Response)
+}
+
+// IsClientError returns true if err is a client error response of the compactor
+// delete API, e.g. an invalid query. Retrying the same request does not succeed.
+// Too many requests responses are not considered client errors.
+func IsClientError(err error) bool {
+	var se *StatusError
+	if !errors.As(err, &se) {
+		return false
+	}
+
+	return se.Code >= 400 && se.Code < 500 && se.Code != http.StatusTooManyRequests
+}
+
+// Client talks to the delete API of a Loki compactor.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the compactor delete API reachable at baseURL,
+// e.g. http://lokistack-dev-compactor-http.default.svc.cluster.local:3100.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// CreateDeleteRequest submits a request to delete the log lines matching query
+// between start and end for the given tenant.
+func (c *Client) CreateDeleteRequest(ctx context.Context, tenant, query string, start, end time.Time) error {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.deleteURL(params), nil)
+	if err != nil {
+		return kverrors.Wrap(err, "failed to create delete request")
+	}
+	req.Header.Set(tenantHeader, tenant)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return kverrors.Wrap(err, "failed to submit delete request", "tenant", tenant)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return responseError(res, "submit delete request")
+	}
+
+	return nil
+}
+
+// ListDeleteRequests returns all delete requests known to the compactor for the given tenant.
+func (c *Client) ListDeleteRequests(ctx context.Context, tenant string) ([]DeleteRequest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.deleteURL(nil), nil)
+	if err != nil {
+		return nil, kverrors.Wrap(err, "failed to create list delete requests request")
+	}
+	req.Header.Set(tenantHeader, tenant)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, kverrors.Wrap(err, "failed to list delete requests", "tenant", tenant)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(res, "list delete requests")
+	}
+
+	var drs []DeleteRequest
+	if err := json.NewDecoder(res.Body).Decode(&drs); err != nil {
+		return nil, kverrors.Wrap(err, "failed to decode delete requests")
+	}
+
+	return drs, nil
+}
+
+func (c *Client) deleteURL(params url.Values) string {
+	u := c.baseURL + deletePath
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	return u
+}
+
+func responseError(res *http.Response, op string) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return &StatusError{
+		Op:       op,
+		Code:     res.StatusCode,
+		Response: strings.TrimSpace(string(body)),
+	}
+}
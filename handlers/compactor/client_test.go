@@ -0,0 +1,168 @@
+package compactor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_CreateDeleteRequest(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	start := time.Unix(1700000000, 0)
+	end := time.Unix(1700003600, 0)
+
+	cl := NewClient(srv.URL+"/", srv.Client())
+	if err := cl.CreateDeleteRequest(context.Background(), "application", `{app="foo"} |= "bar"`, start, end); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Method != http.MethodPost {
+		t.Errorf("got method %s, want %s", got.Method, http.MethodPost)
+	}
+	if got.URL.Path != deletePath {
+		t.Errorf("got path %s, want %s", got.URL.Path, deletePath)
+	}
+	if v := got.Header.Get(tenantHeader); v != "application" {
+		t.Errorf("got tenant %q, want %q", v, "application")
+	}
+
+	q := got.URL.Query()
+	for k, want := range map[string]string{
+		"query": `{app="foo"} |= "bar"`,
+		"start": "1700000000",
+		"end":   "1700003600",
+	} {
+		if v := q.Get(k); v != want {
+			t.Errorf("got %s %q, want %q", k, v, want)
+		}
+	}
+}
+
+func TestClient_ListDeleteRequests(t *testing.T) {
+	var tenant string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != deletePath {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		tenant = r.Header.Get(tenantHeader)
+
+		_, _ = w.Write([]byte(`[
+			{"request_id":"a","start_time":1700000000,"end_time":1700003600,"query":"{app=\"foo\"}","status":"received","created_at":1700007200.123},
+			{"request_id":"b","start_time":1600000000,"end_time":1600003600,"query":"{app=\"bar\"}","status":"processed","created_at":1600007200}
+		]`))
+	}))
+	defer srv.Close()
+
+	drs, err := NewClient(srv.URL, srv.Client()).ListDeleteRequests(context.Background(), "application")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tenant != "application" {
+		t.Errorf("got tenant %q, want %q", tenant, "application")
+	}
+	if len(drs) != 2 {
+		t.Fatalf("got %d delete requests, want 2", len(drs))
+	}
+
+	want := DeleteRequest{
+		RequestID: "a",
+		StartTime: 1700000000,
+		EndTime:   1700003600,
+		Query:     `{app="foo"}`,
+		Status:    DeleteRequestReceived,
+		CreatedAt: 1700007200.123,
+	}
+	if drs[0] != want {
+		t.Errorf("got %#v, want %#v", drs[0], want)
+	}
+	if drs[1].Status != DeleteRequestProcessed {
+		t.Errorf("got status %q, want %q", drs[1].Status, DeleteRequestProcessed)
+	}
+}
+
+func TestClient_StatusErrors(t *testing.T) {
+	tt := []struct {
+		desc        string
+		code        int
+		clientError bool
+	}{
+		{
+			desc:        "bad request",
+			code:        http.StatusBadRequest,
+			clientError: true,
+		},
+		{
+			desc:        "unauthorized",
+			code:        http.StatusUnauthorized,
+			clientError: true,
+		},
+		{
+			desc: "too many requests",
+			code: http.StatusTooManyRequests,
+		},
+		{
+			desc: "internal server error",
+			code: http.StatusInternalServerError,
+		},
+		{
+			desc: "service unavailable",
+			code: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "invalid query", tc.code)
+			}))
+			defer srv.Close()
+
+			cl := NewClient(srv.URL, srv.Client())
+
+			errs := map[string]error{
+				"create": cl.CreateDeleteRequest(context.Background(), "application", `{app="foo"}`, time.Unix(0, 0), time.Unix(60, 0)),
+			}
+			_, errs["list"] = cl.ListDeleteRequests(context.Background(), "application")
+
+			for op, err := range errs {
+				var se *StatusError
+				if !errors.As(err, &se) {
+					t.Fatalf("%s: got error %v, want status error", op, err)
+				}
+				if se.Code != tc.code {
+					t.Errorf("%s: got code %d, want %d", op, se.Code, tc.code)
+				}
+				if se.Response != "invalid query" {
+					t.Errorf("%s: got response %q, want %q", op, se.Response, "invalid query")
+				}
+				if got := IsClientError(err); got != tc.clientError {
+					t.Errorf("%s: got client error %t, want %t", op, got, tc.clientError)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	err := NewClient(srv.URL, nil).CreateDeleteRequest(context.Background(), "application", `{app="foo"}`, time.Unix(0, 0), time.Unix(60, 0))
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if IsClientError(err) {
+		t.Errorf("got client error for an unreachable compactor")
+	}
+}
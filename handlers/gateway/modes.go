@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"fmt"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+// ValidateModes validates the tenants mode specification.
+func ValidateModes(stack *lokiv1.LokiStack) error {
+	tenants := stack.Spec.Tenants
+
+	switch tenants.Mode {
+	case lokiv1.Static:
+		if tenants.Authorization == nil {
+			return invalidTenants("mandatory configuration - missing tenants' authorization spec")
+		}
+
+		if len(tenants.Authorization.Roles) == 0 {
+			return invalidTenants("mandatory configuration - missing roles configuration")
+		}
+
+		if len(tenants.Authorization.RoleBindings) == 0 {
+			return invalidTenants("mandatory configuration - missing role bindings configuration")
+		}
+	case lokiv1.OIDC:
+		if tenants.Authorization != nil {
+			return invalidTenants("incompatible configuration - custom tenants' authorization spec not supported in oidc mode")
+		}
+	case lokiv1.Passthrough:
+		return nil
+	default:
+		return invalidTenants(fmt.Sprintf("unknown tenants mode: %s", tenants.Mode))
+	}
+
+	if len(tenants.Authentication) == 0 {
+		return invalidTenants("mandatory configuration - missing tenants' authentication spec")
+	}
+
+	for _, a := range tenants.Authentication {
+		if a.OIDC == nil {
+			return invalidTenants(fmt.Sprintf("mandatory configuration - missing OIDC configuration for tenant: %s", a.TenantName))
+		}
+	}
+
+	return nil
+}
+
+func invalidTenants(msg string) error {
+	return &status.DegradedError{
+		Message: fmt.Sprintf("Invalid tenants configuration: %s", msg),
+		Reason:  lokiv1.ReasonInvalidTenantsConfiguration,
+		Requeue: false,
+	}
+}
@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+// GetTenantSecrets returns the list to gateway tenant secrets for a tenant mode.
+// For modes static and oidc the secrets are fetched from external provided
+// secrets. Passthrough mode does not require any secrets.
+func GetTenantSecrets(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) ([]*manifests.TenantSecrets, error) {
+	if stack.Spec.Tenants.Mode == lokiv1.Passthrough {
+		return nil, nil
+	}
+
+	var tenantSecrets []*manifests.TenantSecrets
+	for _, tenant := range stack.Spec.Tenants.Authentication {
+		var gatewaySecret corev1.Secret
+		key := client.ObjectKey{Name: tenant.OIDC.Secret.Name, Namespace: stack.Namespace}
+		if err := k.Get(ctx, key, &gatewaySecret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, &status.DegradedError{
+					Message: fmt.Sprintf("Missing secrets for tenant %s", tenant.TenantName),
+					Reason:  lokiv1.ReasonMissingGatewayTenantSecret,
+					Requeue: true,
+				}
+			}
+			return nil, kverrors.Wrap(err, "failed to lookup lokistack gateway tenant secret", "name", key)
+		}
+
+		ts, err := extractSecret(&gatewaySecret, tenant.TenantName)
+		if err != nil {
+			return nil, &status.DegradedError{
+				Message: "Invalid gateway tenant secret contents",
+				Reason:  lokiv1.ReasonInvalidGatewayTenantSecret,
+				Requeue: true,
+			}
+		}
+		tenantSecrets = append(tenantSecrets, ts)
+	}
+
+	return tenantSecrets, nil
+}
+
+// extractSecret reads a k8s secret into a manifest tenant secret struct if valid.
+func extractSecret(s *corev1.Secret, tenantName string) (*manifests.TenantSecrets, error) {
+	clientID := s.Data["clientID"]
+	if len(clientID) == 0 {
+		return nil, kverrors.New("missing clientID field", "field", "clientID")
+	}
+	clientSecret := s.Data["clientSecret"]
+
+	return &manifests.TenantSecrets{
+		TenantName:   tenantName,
+		ClientID:     string(clientID),
+		ClientSecret: string(clientSecret),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/compactor"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+)
+
+// ProcessLogDeletionRequest submits a LogDeletionRequest to the compactor delete API of
+// its LokiStack and tracks the progress in the request status. The compactor is reached
+// through the base URL returned by compactorURL. It returns true while the request is
+// pending and needs to be polled again.
+func ProcessLogDeletionRequest(
+	ctx context.Context,
+	log logr.Logger,
+	req ctrl.Request,
+	k k8s.Client,
+	compactorURL func(stackName, namespace string) string,
+	now time.Time,
+) (bool, error) {
+	ll := log.WithValues("logdeletionrequest", req.NamespacedName, "event", "process")
+
+	var ldr lokiv1.LogDeletionRequest
+	if err := k.Get(ctx, req.NamespacedName, &ldr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, kverrors.Wrap(err, "failed to lookup log deletion request", "name", req.NamespacedName)
+	}
+
+	switch ldr.Status.Phase {
+	case lokiv1.LogDeletionRequestProcessed, lokiv1.LogDeletionRequestFailed:
+		return false, nil
+	}
+
+	var stack lokiv1.LokiStack
+	key := client.ObjectKey{Name: ldr.Spec.StackName, Namespace: ldr.Namespace}
+	if err := k.Get(ctx, key, &stack); err != nil {
+		if apierrors.IsNotFound(err) {
+			msg := fmt.Sprintf("Waiting for LokiStack %q", ldr.Spec.StackName)
+			return true, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestPending, msg)
+		}
+		return false, kverrors.Wrap(err, "failed to lookup lokistack", "key", key)
+	}
+
+	cl := compactor.NewClient(compactorURL(stack.Name, stack.Namespace), nil)
+	start := ldr.Spec.Start.Time
+
+	// Persist the end of the time range before submitting the request. A missing end
+	// defaults to now and the request is matched against the compactor delete requests
+	// by its time range. Thus it must not change between reconciles.
+	if ldr.Status.End == nil {
+		end := now
+		if ldr.Spec.End != nil {
+			end = ldr.Spec.End.Time
+		}
+
+		if !end.After(start) {
+			msg := "The end of the time range must be after its start"
+			return false, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestFailed, msg)
+		}
+
+		ldr.Status.End = &metav1.Time{Time: end}
+		if err := updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestPending, "Submitting to the compactor"); err != nil {
+			return false, err
+		}
+	}
+
+	// List the existing delete requests before submitting one, so that the request is
+	// not submitted twice when updating the status after the submission failed.
+	drs, err := cl.ListDeleteRequests(ctx, ldr.Spec.TenantID)
+	if err != nil {
+		return compactorError(ctx, k, &ldr, err)
+	}
+
+	matched, processed := matchDeleteRequests(drs, ldr.Spec.Query, start, ldr.Status.End.Time)
+
+	switch {
+	case matched == 0 && ldr.Status.SubmittedAt == nil:
+		if err := cl.CreateDeleteRequest(ctx, ldr.Spec.TenantID, ldr.Spec.Query, start, ldr.Status.End.Time); err != nil {
+			return compactorError(ctx, k, &ldr, err)
+		}
+
+		ll.Info("submitted log deletion request to the compactor", "tenant", ldr.Spec.TenantID)
+
+		submittedAt := metav1.NewTime(now)
+		ldr.Status.SubmittedAt = &submittedAt
+
+		return true, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestPending, "Submitted to the compactor")
+	case matched == 0:
+		return true, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestPending, "Waiting for the compactor to register the request")
+	case processed < matched:
+		if ldr.Status.SubmittedAt == nil {
+			submittedAt := metav1.NewTime(now)
+			ldr.Status.SubmittedAt = &submittedAt
+		}
+		return true, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestPending, "Waiting for the compactor to process the request")
+	default:
+		return false, updateLogDeletionRequestStatus(ctx, k, &ldr, lokiv1.LogDeletionRequestProcessed, "Processed by the compactor")
+	}
+}
+
+// matchDeleteRequests returns the number of compactor delete requests matching the
+// query and the time range as well as the number of those already processed.
+func matchDeleteRequests(drs []compactor.DeleteRequest, query string, start, end time.Time) (matched, processed int) {
+	for _, dr := range drs {
+		if dr.Query != query ||
+			int64(dr.StartTime) != start.Unix() ||
+			int64(dr.EndTime) != end.Unix() {
+			continue
+		}
+
+		matched++
+		if dr.Status == compactor.DeleteRequestProcessed {
+			processed++
+		}
+	}
+
+	return matched, processed
+}
+
+// compactorError marks the request as failed if the compactor rejected it with a
+// client error. Other errors are returned to retry the request.
+func compactorError(ctx context.Context, k k8s.Client, ldr *lokiv1.LogDeletionRequest, err error) (bool, error) {
+	if !compactor.IsClientError(err) {
+		return false, err
+	}
+
+	msg := fmt.Sprintf("The compactor rejected the request: %s", err)
+	return false, updateLogDeletionRequestStatus(ctx, k, ldr, lokiv1.LogDeletionRequestFailed, msg)
+}
+
+func updateLogDeletionRequestStatus(ctx context.Context, k k8s.Client, ldr *lokiv1.LogDeletionRequest, phase lokiv1.LogDeletionRequestPhase, msg string) error {
+	if ldr.Status.Phase == phase && ldr.Status.Message == msg {
+		return nil
+	}
+
+	ldr.Status.Phase = phase
+	ldr.Status.Message = msg
+
+	if err := k.Status().Update(ctx, ldr); err != nil {
+		return kverrors.Wrap(err, "failed to update log deletion request status", "name", ldr.Name)
+	}
+
+	return nil
+}
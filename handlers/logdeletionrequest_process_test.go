@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/compactor"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add client-go scheme: %s", err)
+	}
+	if err := lokiv1.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add loki scheme: %s", err)
+	}
+
+	return s
+}
+
+// fakeCompactor serves the compactor delete API from a list of delete requests.
+type fakeCompactor struct {
+	mu       sync.Mutex
+	requests []compactor.DeleteRequest
+	creates  int
+	code     int
+}
+
+func (c *fakeCompactor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.code != 0 {
+		http.Error(w, "error", c.code)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		_ = json.NewEncoder(w).Encode(c.requests)
+	case http.MethodPost:
+		c.creates++
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestMatchDeleteRequests(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	end := time.Unix(1700003600, 0)
+	query := `{app="foo"}`
+
+	tt := []struct {
+		desc      string
+		drs       []compactor.DeleteRequest
+		matched   int
+		processed int
+	}{
+		{
+			desc: "no requests",
+		},
+		{
+			desc: "matching request received",
+			drs: []compactor.DeleteRequest{
+				{Query: query, StartTime: 1700000000, EndTime: 1700003600, Status: compactor.DeleteRequestReceived},
+			},
+			matched: 1,
+		},
+		{
+			desc: "matching requests partially processed",
+			drs: []compactor.DeleteRequest{
+				{Query: query, StartTime: 1700000000, EndTime: 1700003600, Status: compactor.DeleteRequestProcessed},
+				{Query: query, StartTime: 1700000000, EndTime: 1700003600, Status: compactor.DeleteRequestReceived},
+			},
+			matched:   2,
+			processed: 1,
+		},
+		{
+			desc: "fractional seconds",
+			drs: []compactor.DeleteRequest{
+				{Query: query, StartTime: 1700000000.5, EndTime: 1700003600.5, Status: compactor.DeleteRequestProcessed},
+			},
+			matched:   1,
+			processed: 1,
+		},
+		{
+			desc: "other query",
+			drs: []compactor.DeleteRequest{
+				{Query: `{app="bar"}`, StartTime: 1700000000, EndTime: 1700003600},
+			},
+		},
+		{
+			desc: "other start",
+			drs: []compactor.DeleteRequest{
+				{Query: query, StartTime: 1700000001, EndTime: 1700003600},
+			},
+		},
+		{
+			desc: "other end",
+			drs: []compactor.DeleteRequest{
+				{Query: query, StartTime: 1700000000, EndTime: 1700003601},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			matched, processed := matchDeleteRequests(tc.drs, query, start, end)
+			if matched != tc.matched || processed != tc.processed {
+				t.Errorf("got %d matched and %d processed, want %d and %d", matched, processed, tc.matched, tc.processed)
+			}
+		})
+	}
+}
+
+func processLogDeletionRequest(t *testing.T, ldr *lokiv1.LogDeletionRequest, fc *fakeCompactor, now time.Time) (bool, error) {
+	t.Helper()
+
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	stack := &lokiv1.LokiStack{
+		ObjectMeta: metav1.ObjectMeta{Name: ldr.Spec.StackName, Namespace: ldr.Namespace},
+	}
+
+	k := fake.NewClientBuilder().
+		WithScheme(testScheme(t)).
+		WithObjects(stack, ldr).
+		WithStatusSubresource(ldr).
+		Build()
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ldr.Name, Namespace: ldr.Namespace}}
+	compactorURL := func(string, string) string { return srv.URL }
+
+	pending, err := ProcessLogDeletionRequest(context.Background(), logr.Discard(), req, k, compactorURL, now)
+
+	if gerr := k.Get(context.Background(), client.ObjectKeyFromObject(ldr), ldr); gerr != nil {
+		t.Fatalf("failed to get log deletion request: %s", gerr)
+	}
+
+	return pending, err
+}
+
+func testLogDeletionRequest() *lokiv1.LogDeletionRequest {
+	return &lokiv1.LogDeletionRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "delete", Namespace: "test-ns"},
+		Spec: lokiv1.LogDeletionRequestSpec{
+			StackName: "test",
+			TenantID:  "application",
+			Query:     `{app="foo"}`,
+			Start:     metav1.NewTime(time.Unix(1700000000, 0)),
+		},
+	}
+}
+
+func TestProcessLogDeletionRequest_Submits(t *testing.T) {
+	now := time.Unix(1700003600, 0)
+	ldr := testLogDeletionRequest()
+	fc := &fakeCompactor{}
+
+	pending, err := processLogDeletionRequest(t, ldr, fc, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !pending {
+		t.Errorf("want request pending")
+	}
+	if fc.creates != 1 {
+		t.Errorf("got %d submitted requests, want 1", fc.creates)
+	}
+	if ldr.Status.Phase != lokiv1.LogDeletionRequestPending || ldr.Status.SubmittedAt == nil {
+		t.Errorf("got status %#v, want pending and submitted", ldr.Status)
+	}
+	if ldr.Status.End == nil || !ldr.Status.End.Time.Equal(now) {
+		t.Errorf("got end %v, want %v", ldr.Status.End, now)
+	}
+}
+
+func TestProcessLogDeletionRequest_DoesNotResubmit(t *testing.T) {
+	end := time.Unix(1700003600, 0)
+
+	// The request was submitted, but recording the submission in the status failed.
+	ldr := testLogDeletionRequest()
+	ldr.Status.Phase = lokiv1.LogDeletionRequestPending
+	ldr.Status.End = &metav1.Time{Time: end}
+
+	fc := &fakeCompactor{
+		requests: []compactor.DeleteRequest{
+			{Query: ldr.Spec.Query, StartTime: 1700000000, EndTime: 1700003600, Status: compactor.DeleteRequestReceived},
+		},
+	}
+
+	pending, err := processLogDeletionRequest(t, ldr, fc, end.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !pending {
+		t.Errorf("want request pending")
+	}
+	if fc.creates != 0 {
+		t.Errorf("got %d submitted requests, want none", fc.creates)
+	}
+	if ldr.Status.SubmittedAt == nil {
+		t.Errorf("want submitted at recorded")
+	}
+	if !ldr.Status.End.Time.Equal(end) {
+		t.Errorf("got end %v, want %v", ldr.Status.End.Time, end)
+	}
+}
+
+func TestProcessLogDeletionRequest_Processed(t *testing.T) {
+	end := time.Unix(1700003600, 0)
+	submittedAt := metav1.NewTime(end)
+
+	ldr := testLogDeletionRequest()
+	ldr.Status.Phase = lokiv1.LogDeletionRequestPending
+	ldr.Status.End = &metav1.Time{Time: end}
+	ldr.Status.SubmittedAt = &submittedAt
+
+	fc := &fakeCompactor{
+		requests: []compactor.DeleteRequest{
+			{Query: ldr.Spec.Query, StartTime: 1700000000, EndTime: 1700003600, Status: compactor.DeleteRequestProcessed},
+		},
+	}
+
+	pending, err := processLogDeletionRequest(t, ldr, fc, end.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pending {
+		t.Errorf("want request not pending")
+	}
+	if ldr.Status.Phase != lokiv1.LogDeletionRequestProcessed {
+		t.Errorf("got phase %s, want %s", ldr.Status.Phase, lokiv1.LogDeletionRequestProcessed)
+	}
+}
+
+func TestProcessLogDeletionRequest_ClientErrorFails(t *testing.T) {
+	ldr := testLogDeletionRequest()
+	fc := &fakeCompactor{code: http.StatusBadRequest}
+
+	pending, err := processLogDeletionRequest(t, ldr, fc, time.Unix(1700003600, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pending {
+		t.Errorf("want request not pending")
+	}
+	if ldr.Status.Phase != lokiv1.LogDeletionRequestFailed {
+		t.Errorf("got phase %s, want %s", ldr.Status.Phase, lokiv1.LogDeletionRequestFailed)
+	}
+}
+
+func TestProcessLogDeletionRequest_ServerErrorRetries(t *testing.T) {
+	ldr := testLogDeletionRequest()
+	fc := &fakeCompactor{code: http.StatusServiceUnavailable}
+
+	_, err := processLogDeletionRequest(t, ldr, fc, time.Unix(1700003600, 0))
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if ldr.Status.Phase != lokiv1.LogDeletionRequestPending {
+		t.Errorf("got phase %s, want %s", ldr.Status.Phase, lokiv1.LogDeletionRequestPending)
+	}
+}
+
+func TestProcessLogDeletionRequest_InvalidTimeRangeFails(t *testing.T) {
+	ldr := testLogDeletionRequest()
+	end := metav1.NewTime(ldr.Spec.Start.Add(-time.Hour))
+	ldr.Spec.End = &end
+	fc := &fakeCompactor{}
+
+	if _, err := processLogDeletionRequest(t, ldr, fc, time.Unix(1700003600, 0)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ldr.Status.Phase != lokiv1.LogDeletionRequestFailed {
+		t.Errorf("got phase %s, want %s", ldr.Status.Phase, lokiv1.LogDeletionRequestFailed)
+	}
+	if fc.creates != 0 {
+		t.Errorf("got %d submitted requests, want none", fc.creates)
+	}
+}
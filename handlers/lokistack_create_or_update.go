@@ -15,8 +15,10 @@ import (
 
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/gateway"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/serviceaccounts"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/rules"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/storage"
 )
@@ -44,12 +46,48 @@ func CreateOrUpdateLokiStack(
 		return err
 	}
 
+	var tenantSecrets []*manifests.TenantSecrets
+	if stack.Spec.Tenants != nil {
+		ll.Info("1-1: Config Gateway Tenants")
+
+		if err = gateway.ValidateModes(&stack); err != nil {
+			return err
+		}
+
+		tenantSecrets, err = gateway.GetTenantSecrets(ctx, k, &stack)
+		if err != nil {
+			return err
+		}
+	}
+
+	alertingRules, recordingRules, err := rules.List(ctx, k, req.Namespace, stack.Spec.Rules)
+	if err != nil {
+		return err
+	}
+
+	if err := rules.ValidateRemoteWriteSecret(ctx, k, &stack); err != nil {
+		return err
+	}
+
+	rulerConfig, rulerSecretSHA1, err := rules.GetRulerConfig(ctx, k, &stack)
+	if err != nil {
+		return err
+	}
+
 	opts := manifests.Options{
 		Name:          req.Name,
 		Namespace:     req.Namespace,
 		Image:         manifests.DefaultContainerImage,
+		GatewayImage:  manifests.DefaultLokiStackGatewayImage,
 		Stack:         stack.Spec,
 		ObjectStorage: objStore,
+		Tenants: manifests.Tenants{
+			Secrets: tenantSecrets,
+		},
+		AlertingRules:   alertingRules,
+		RecordingRules:  recordingRules,
+		RulerConfig:     rulerConfig,
+		RulerSecretSHA1: rulerSecretSHA1,
 	}
 
 	ll.Info("2: Config Default settings")
@@ -59,6 +97,10 @@ func CreateOrUpdateLokiStack(
 		return optErr
 	}
 
+	if err := validateReplication(ctx, k, &opts.Stack); err != nil {
+		return err
+	}
+
 	ll.Info("3: Build all components")
 
 	objects, err := manifests.BuildAll(opts)
@@ -67,12 +109,15 @@ func CreateOrUpdateLokiStack(
 		return err
 	}
 
-	if err := status.SetStorageSchemaStatus(ctx, k, req, objStore.Schemas); err != nil {
-		ll.Error(err, "failed to set storage schema status")
+	if err := status.SetStorageStatus(ctx, k, req, objStore.Schemas, objStore.CredentialMode, objStore.PreflightHash); err != nil {
+		ll.Error(err, "failed to set storage status")
 		return err
 	}
 
-	var errCount int32
+	var (
+		errCount int32
+		rollout  zoneRollout
+	)
 
 	for _, obj := range objects {
 		l := ll.WithValues(
@@ -96,6 +141,17 @@ func CreateOrUpdateLokiStack(
 			return err
 		}
 
+		skip, err := rollout.skip(ctx, k, obj)
+		if err != nil {
+			l.Error(err, "failed to check ingester zone rollout")
+			errCount++
+			continue
+		}
+		if skip {
+			l.Info("Waiting for the rollout of the previous ingester zone")
+			continue
+		}
+
 		desired := obj.DeepCopyObject().(client.Object)
 		mutateFn := manifests.MutateFuncFor(obj, desired, depAnnotations)
 
@@ -105,6 +161,7 @@ func CreateOrUpdateLokiStack(
 			errCount++
 			continue
 		}
+		rollout.observe(obj, op)
 
 		msg := fmt.Sprintf("Resource has been %s", op)
 		switch op {
@@ -119,6 +176,16 @@ func CreateOrUpdateLokiStack(
 		return kverrors.New("failed to configure lokistack resources", "name", req.NamespacedName)
 	}
 
+	if err := rules.Cleanup(ctx, k, &stack, objects); err != nil {
+		ll.Error(err, "failed to cleanup stale ruler resources")
+		return err
+	}
+
+	if err := rollout.cleanup(ctx, k, &stack, objects); err != nil {
+		ll.Error(err, "failed to cleanup stale ingester resources")
+		return err
+	}
+
 	ll.Info("Create or Update Lokistack end")
 
 	return nil
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+var (
+	errZoneModeZones          = errors.New("statefulset-per-zone requires exactly one zone spec")
+	errZoneModeMissingValues  = errors.New("statefulset-per-zone requires the zone values")
+	errZoneModeInvalidValue   = errors.New("zone value is not a valid DNS label")
+	errZoneModeDuplicateValue = errors.New("duplicate zone value")
+	errZoneModeReplicas       = errors.New("fewer ingester replicas than zones")
+)
+
+// validateReplication ensures that the replication factor of the defaulted stack spec
+// can be satisfied by the ingester replicas and, if zone-aware, by the available zones.
+func validateReplication(ctx context.Context, k k8s.Client, spec *lokiv1.LokiStackSpec) error {
+	if err := validateZoneMode(spec); err != nil {
+		return &status.DegradedError{
+			Message: fmt.Sprintf("Invalid zone mode configuration: %s", err),
+			Reason:  lokiv1.ReasonInvalidZoneModeConfiguration,
+			Requeue: false,
+		}
+	}
+
+	if spec.Replication == nil || spec.Replication.Factor <= 1 {
+		return nil
+	}
+	factor := spec.Replication.Factor
+
+	if t := spec.Template; t != nil && t.Ingester != nil && t.Ingester.Replicas < factor {
+		return &status.DegradedError{
+			Message: fmt.Sprintf("Replication factor %d exceeds the number of ingester replicas %d", factor, t.Ingester.Replicas),
+			Reason:  lokiv1.ReasonInvalidReplicationConfiguration,
+			Requeue: false,
+		}
+	}
+
+	if len(spec.Replication.Zones) == 0 {
+		return nil
+	}
+
+	if zones := manifests.IngesterZones(spec.Replication); len(zones) > 0 && len(zones) < int(factor) {
+		return &status.DegradedError{
+			Message: fmt.Sprintf("Replication factor %d exceeds the number of ingester zones %d", factor, len(zones)),
+			Reason:  lokiv1.ReasonInvalidReplicationConfiguration,
+			Requeue: false,
+		}
+	}
+
+	// Missing or empty zone labels are reported by the status refresh.
+	nodesOk, labelsOk, zones, err := status.CheckForZoneawareNodes(ctx, k, spec.Replication.Zones)
+	if err != nil {
+		return err
+	}
+
+	if nodesOk && labelsOk && zones < int(factor) {
+		return &status.DegradedError{
+			Message: fmt.Sprintf("Replication factor %d exceeds the number of available zones %d", factor, zones),
+			Reason:  lokiv1.ReasonInvalidReplicationConfiguration,
+			Requeue: true,
+		}
+	}
+
+	return nil
+}
+
+// validateZoneMode ensures that the statefulset-per-zone zone mode uses a single zone spec
+// listing distinct zone values, each running at least one ingester.
+func validateZoneMode(spec *lokiv1.LokiStackSpec) error {
+	r := spec.Replication
+	if r == nil || r.ZoneMode != lokiv1.ReplicationZoneModeStatefulSetPerZone {
+		return nil
+	}
+
+	if len(r.Zones) != 1 {
+		return errZoneModeZones
+	}
+
+	values := r.Zones[0].Values
+	if len(values) == 0 {
+		return errZoneModeMissingValues
+	}
+
+	seen := map[string]struct{}{}
+	for _, v := range values {
+		if errs := validation.IsDNS1123Label(v); len(errs) > 0 {
+			return fmt.Errorf("%w: %s", errZoneModeInvalidValue, v)
+		}
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("%w: %s", errZoneModeDuplicateValue, v)
+		}
+		seen[v] = struct{}{}
+	}
+
+	if t := spec.Template; t != nil && t.Ingester != nil && t.Ingester.Replicas < int32(len(values)) {
+		return fmt.Errorf("%w: %d", errZoneModeReplicas, t.Ingester.Replicas)
+	}
+
+	return nil
+}
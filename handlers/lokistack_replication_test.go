@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+)
+
+func TestValidateZoneMode(t *testing.T) {
+	zoneSpec := func(values ...string) []lokiv1.ZoneSpec {
+		return []lokiv1.ZoneSpec{
+			{
+				TopologyKey: "topology.kubernetes.io/zone",
+				Values:      values,
+			},
+		}
+	}
+
+	tt := []struct {
+		desc string
+		spec lokiv1.LokiStackSpec
+		want error
+	}{
+		{
+			desc: "no replication",
+		},
+		{
+			desc: "default zone mode",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					Zones: zoneSpec(),
+				},
+			},
+		},
+		{
+			desc: "valid zones",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    zoneSpec("a", "b", "c"),
+				},
+				Template: &lokiv1.LokiTemplateSpec{
+					Ingester: &lokiv1.LokiComponentSpec{Replicas: 3},
+				},
+			},
+		},
+		{
+			desc: "missing zone spec",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+				},
+			},
+			want: errZoneModeZones,
+		},
+		{
+			desc: "multiple zone specs",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    append(zoneSpec("a"), zoneSpec("b")...),
+				},
+			},
+			want: errZoneModeZones,
+		},
+		{
+			desc: "missing zone values",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    zoneSpec(),
+				},
+			},
+			want: errZoneModeMissingValues,
+		},
+		{
+			desc: "invalid zone value",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    zoneSpec("eu_west_1a"),
+				},
+			},
+			want: errZoneModeInvalidValue,
+		},
+		{
+			desc: "duplicate zone value",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    zoneSpec("a", "b", "a"),
+				},
+			},
+			want: errZoneModeDuplicateValue,
+		},
+		{
+			desc: "fewer ingesters than zones",
+			spec: lokiv1.LokiStackSpec{
+				Replication: &lokiv1.ReplicationSpec{
+					ZoneMode: lokiv1.ReplicationZoneModeStatefulSetPerZone,
+					Zones:    zoneSpec("a", "b", "c"),
+				},
+				Template: &lokiv1.LokiTemplateSpec{
+					Ingester: &lokiv1.LokiComponentSpec{Replicas: 2},
+				},
+			},
+			want: errZoneModeReplicas,
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := validateZoneMode(&tc.spec)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("got error %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+)
+
+// AnnotateForDiscoveredRules adds/updates the `loki.grafana.com/rulesDiscoveredAt` annotation
+// to all instance of LokiStack on all namespaces to trigger the reconciliation loop.
+func AnnotateForDiscoveredRules(ctx context.Context, k k8s.Client) error {
+	timeStamp := time.Now().UTC().Format(time.RFC3339)
+
+	var stacks lokiv1.LokiStackList
+	err := k.List(ctx, &stacks)
+	if err != nil {
+		return kverrors.Wrap(err, "failed to list any lokistack instances")
+	}
+
+	for i := range stacks.Items {
+		s := &stacks.Items[i]
+		if s.Spec.Rules == nil || !s.Spec.Rules.Enabled {
+			continue
+		}
+
+		patch := client.MergeFrom(s.DeepCopy())
+		if s.Annotations == nil {
+			s.Annotations = map[string]string{}
+		}
+		s.Annotations[lokiv1.AnnotationRulesDiscoveredAt] = timeStamp
+
+		if err := k.Patch(ctx, s, patch); err != nil {
+			return kverrors.Wrap(err, "failed to annotate lokistack", "name", s.Name, "namespace", s.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// AnnotateForRulerConfig adds/updates the `loki.grafana.com/rulerConfigDiscoveredAt` annotation
+// to the named LokiStack in the same namespace of the RulerConfig. If no LokiStack is found, then
+// skip reconciliation.
+func AnnotateForRulerConfig(ctx context.Context, k k8s.Client, name, namespace string) error {
+	var s lokiv1.LokiStack
+	key := client.ObjectKey{Name: name, Namespace: namespace}
+
+	if err := k.Get(ctx, key, &s); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return kverrors.Wrap(err, "failed to get lokistack", "key", key)
+	}
+
+	patch := client.MergeFrom(s.DeepCopy())
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[lokiv1.AnnotationRulerConfigDiscoveredAt] = time.Now().UTC().Format(time.RFC3339)
+
+	if err := k.Patch(ctx, &s, patch); err != nil {
+		return kverrors.Wrap(err, "failed to annotate lokistack", "key", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+)
+
+// zoneRollout rolls out the ingester StatefulSets of the statefulset-per-zone zone mode
+// one zone at a time. An existing zone is updated only when all zones before it are
+// rolled out, i.e. all their ingesters are updated and ready. The ingester readiness
+// probe succeeds only for ingesters that are ACTIVE in the ring.
+type zoneRollout struct {
+	inProgress bool
+	waiting    bool
+}
+
+// skip returns true if the object is an existing zone StatefulSet that has to wait
+// for the rollout of a previous zone. New zones are created right away.
+func (r *zoneRollout) skip(ctx context.Context, k k8s.Client, obj client.Object) (bool, error) {
+	if !r.inProgress || !isIngesterZone(obj) {
+		return false, nil
+	}
+
+	var sts appsv1.StatefulSet
+	if err := k.Get(ctx, client.ObjectKeyFromObject(obj), &sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, kverrors.Wrap(err, "failed to lookup ingester zone statefulset", "name", obj.GetName())
+	}
+
+	r.waiting = true
+	return true, nil
+}
+
+// observe records if the zone StatefulSet has been updated or is still rolling out
+// after it has been created or updated.
+func (r *zoneRollout) observe(obj client.Object, op ctrlutil.OperationResult) {
+	if !isIngesterZone(obj) {
+		return
+	}
+
+	sts := obj.(*appsv1.StatefulSet)
+	if op == ctrlutil.OperationResultUpdated || !statefulSetRolledOut(sts) {
+		r.inProgress = true
+	}
+}
+
+// cleanup deletes the ingester StatefulSets that are no longer desired. The previous
+// ingesters are kept until all ingester zones are rolled out.
+func (r *zoneRollout) cleanup(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack, desired []client.Object) error {
+	if r.inProgress {
+		return nil
+	}
+
+	return cleanupIngesters(ctx, k, stack, desired)
+}
+
+func isIngesterZone(obj client.Object) bool {
+	if _, ok := obj.(*appsv1.StatefulSet); !ok {
+		return false
+	}
+
+	_, ok := obj.GetLabels()[lokiv1.LabelIngesterZone]
+	return ok
+}
+
+func statefulSetRolledOut(sts *appsv1.StatefulSet) bool {
+	replicas := ptr.Deref(sts.Spec.Replicas, 1)
+	s := sts.Status
+
+	return s.ObservedGeneration >= sts.Generation &&
+		s.UpdateRevision == s.CurrentRevision &&
+		s.UpdatedReplicas == replicas &&
+		s.ReadyReplicas == replicas
+}
+
+// cleanupIngesters deletes the ingester StatefulSets that are no longer desired, e.g.
+// when switching the zone mode or removing a zone value.
+func cleanupIngesters(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack, desired []client.Object) error {
+	keep := map[string]struct{}{}
+	for _, obj := range desired {
+		if _, ok := obj.(*appsv1.StatefulSet); ok {
+			keep[obj.GetName()] = struct{}{}
+		}
+	}
+
+	var stsl appsv1.StatefulSetList
+	opts := []client.ListOption{
+		client.InNamespace(stack.Namespace),
+		client.MatchingLabels(manifests.ComponentLabels(manifests.LabelIngesterComponent, stack.Name)),
+	}
+	if err := k.List(ctx, &stsl, opts...); err != nil {
+		return kverrors.Wrap(err, "failed to list ingester statefulsets", "name", stack.Name)
+	}
+
+	for i := range stsl.Items {
+		sts := &stsl.Items[i]
+		if _, ok := keep[sts.Name]; ok {
+			continue
+		}
+
+		if err := k.Delete(ctx, sts, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return kverrors.Wrap(err, "failed to delete ingester statefulset", "name", sts.Name)
+		}
+	}
+
+	return nil
+}
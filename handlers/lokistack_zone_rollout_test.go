@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+)
+
+func testIngester(name, zone string) *appsv1.StatefulSet {
+	l := manifests.ComponentLabels(manifests.LabelIngesterComponent, "test")
+	if zone != "" {
+		l[lokiv1.LabelIngesterZone] = zone
+	}
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+			Labels:    l,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+}
+
+// rolledOut sets the status of the StatefulSet to all replicas updated and ready.
+func rolledOut(sts *appsv1.StatefulSet) *appsv1.StatefulSet {
+	replicas := ptr.Deref(sts.Spec.Replicas, 1)
+	sts.Status = appsv1.StatefulSetStatus{
+		ObservedGeneration: sts.Generation,
+		CurrentRevision:    "rev",
+		UpdateRevision:     "rev",
+		UpdatedReplicas:    replicas,
+		ReadyReplicas:      replicas,
+	}
+	return sts
+}
+
+func TestZoneRollout_Skip(t *testing.T) {
+	existing := testIngester(manifests.IngesterZoneName("test", "a"), "a")
+	k := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(existing).Build()
+
+	tt := []struct {
+		desc       string
+		inProgress bool
+		obj        client.Object
+		want       bool
+	}{
+		{
+			desc: "no rollout in progress",
+			obj:  testIngester(manifests.IngesterZoneName("test", "a"), "a"),
+		},
+		{
+			desc:       "existing zone",
+			inProgress: true,
+			obj:        testIngester(manifests.IngesterZoneName("test", "a"), "a"),
+			want:       true,
+		},
+		{
+			desc:       "new zone",
+			inProgress: true,
+			obj:        testIngester(manifests.IngesterZoneName("test", "b"), "b"),
+		},
+		{
+			desc:       "ingester without zone",
+			inProgress: true,
+			obj:        testIngester(manifests.IngesterName("test"), ""),
+		},
+		{
+			desc:       "other object",
+			inProgress: true,
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: manifests.IngesterZoneName("test", "a"), Namespace: "test-ns"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			r := zoneRollout{inProgress: tc.inProgress}
+
+			got, err := r.skip(context.Background(), k, tc.obj)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("got skip %t, want %t", got, tc.want)
+			}
+			if r.waiting != tc.want {
+				t.Errorf("got waiting %t, want %t", r.waiting, tc.want)
+			}
+		})
+	}
+}
+
+func TestZoneRollout_Observe(t *testing.T) {
+	tt := []struct {
+		desc string
+		obj  client.Object
+		op   ctrlutil.OperationResult
+		want bool
+	}{
+		{
+			desc: "unchanged and rolled out",
+			obj:  rolledOut(testIngester(manifests.IngesterZoneName("test", "a"), "a")),
+			op:   ctrlutil.OperationResultNone,
+		},
+		{
+			desc: "unchanged and rolling out",
+			obj:  testIngester(manifests.IngesterZoneName("test", "a"), "a"),
+			op:   ctrlutil.OperationResultNone,
+			want: true,
+		},
+		{
+			desc: "created",
+			obj:  testIngester(manifests.IngesterZoneName("test", "a"), "a"),
+			op:   ctrlutil.OperationResultCreated,
+			want: true,
+		},
+		{
+			desc: "updated",
+			obj:  rolledOut(testIngester(manifests.IngesterZoneName("test", "a"), "a")),
+			op:   ctrlutil.OperationResultUpdated,
+			want: true,
+		},
+		{
+			desc: "ingester without zone",
+			obj:  testIngester(manifests.IngesterName("test"), ""),
+			op:   ctrlutil.OperationResultUpdated,
+		},
+		{
+			desc: "other object",
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: manifests.IngesterZoneName("test", "a")},
+			},
+			op: ctrlutil.OperationResultUpdated,
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			var r zoneRollout
+			r.observe(tc.obj, tc.op)
+
+			if r.inProgress != tc.want {
+				t.Errorf("got in progress %t, want %t", r.inProgress, tc.want)
+			}
+		})
+	}
+}
+
+// reconcileIngesters applies the desired ingester StatefulSets like the LokiStack
+// reconciliation and returns the zone rollout.
+func reconcileIngesters(t *testing.T, k k8s.Client, stack *lokiv1.LokiStack, desired []client.Object) zoneRollout {
+	t.Helper()
+
+	var r zoneRollout
+	for _, d := range desired {
+		obj := d.DeepCopyObject().(client.Object)
+
+		skip, err := r.skip(context.Background(), k, obj)
+		if err != nil {
+			t.Fatalf("failed to check zone rollout: %s", err)
+		}
+		if skip {
+			continue
+		}
+
+		op, err := ctrl.CreateOrUpdate(context.Background(), k, obj, func() error { return nil })
+		if err != nil {
+			t.Fatalf("failed to apply %s: %s", obj.GetName(), err)
+		}
+		r.observe(obj, op)
+	}
+
+	if err := r.cleanup(context.Background(), k, stack, desired); err != nil {
+		t.Fatalf("failed to cleanup ingesters: %s", err)
+	}
+
+	return r
+}
+
+func setRolledOut(t *testing.T, k k8s.Client, name string) {
+	t.Helper()
+
+	var sts appsv1.StatefulSet
+	if err := k.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "test-ns"}, &sts); err != nil {
+		t.Fatalf("failed to get %s: %s", name, err)
+	}
+	if err := k.Status().Update(context.Background(), rolledOut(&sts)); err != nil {
+		t.Fatalf("failed to update status of %s: %s", name, err)
+	}
+}
+
+func ingesterExists(t *testing.T, k k8s.Client, name string) bool {
+	t.Helper()
+
+	var sts appsv1.StatefulSet
+	err := k.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "test-ns"}, &sts)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		t.Fatalf("failed to get %s: %s", name, err)
+	}
+	return true
+}
+
+func TestZoneRollout_KeepsIngesterUntilZonesReady(t *testing.T) {
+	stack := &lokiv1.LokiStack{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test-ns"},
+	}
+	legacy := rolledOut(testIngester(manifests.IngesterName("test"), ""))
+	zoneA := manifests.IngesterZoneName("test", "a")
+	zoneB := manifests.IngesterZoneName("test", "b")
+	desired := []client.Object{
+		testIngester(zoneA, "a"),
+		testIngester(zoneB, "b"),
+	}
+
+	k := fake.NewClientBuilder().
+		WithScheme(testScheme(t)).
+		WithObjects(legacy).
+		WithStatusSubresource(&appsv1.StatefulSet{}).
+		Build()
+
+	// Switching the zone mode creates all zones right away.
+	r := reconcileIngesters(t, k, stack, desired)
+	if !r.inProgress {
+		t.Errorf("want rollout in progress for new zones")
+	}
+	for _, name := range []string{zoneA, zoneB} {
+		if !ingesterExists(t, k, name) {
+			t.Errorf("missing ingester zone %s", name)
+		}
+	}
+	if !ingesterExists(t, k, legacy.Name) {
+		t.Fatalf("ingester %s deleted before the zones are ready", legacy.Name)
+	}
+
+	setRolledOut(t, k, zoneA)
+	reconcileIngesters(t, k, stack, desired)
+	if !ingesterExists(t, k, legacy.Name) {
+		t.Fatalf("ingester %s deleted before all zones are ready", legacy.Name)
+	}
+
+	setRolledOut(t, k, zoneB)
+	r = reconcileIngesters(t, k, stack, desired)
+	if r.inProgress {
+		t.Errorf("want rollout completed")
+	}
+	if ingesterExists(t, k, legacy.Name) {
+		t.Errorf("ingester %s not deleted after all zones are ready", legacy.Name)
+	}
+	for _, name := range []string{zoneA, zoneB} {
+		if !ingesterExists(t, k, name) {
+			t.Errorf("ingester zone %s deleted", name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+)
+
+var errNoZoneLabel = errors.New("node is missing the zone label")
+
+// AnnotatePodWithAvailabilityZone sets the `loki.grafana.com/availability-zone` annotation
+// of a scheduled zone-aware pod to the values of the topology labels of its node, joined by "_".
+// The labels are read from the `loki.grafana.com/availability-zone-labels` annotation of the pod.
+func AnnotatePodWithAvailabilityZone(ctx context.Context, log logr.Logger, k k8s.Client, pod *corev1.Pod) error {
+	ll := log.WithValues("pod", client.ObjectKeyFromObject(pod), "node", pod.Spec.NodeName)
+
+	if pod.Spec.NodeName == "" || pod.Annotations[lokiv1.AnnotationAvailabilityZone] != "" {
+		return nil
+	}
+
+	zoneLabels := pod.Annotations[lokiv1.AnnotationAvailabilityZoneLabels]
+	if zoneLabels == "" {
+		return nil
+	}
+
+	var node corev1.Node
+	if err := k.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, &node); err != nil {
+		return kverrors.Wrap(err, "failed to lookup node", "name", pod.Spec.NodeName)
+	}
+
+	labels := strings.Split(zoneLabels, ",")
+	values := make([]string, 0, len(labels))
+	for _, label := range labels {
+		value, ok := node.Labels[label]
+		if !ok || value == "" {
+			return fmt.Errorf("%w: %s", errNoZoneLabel, label)
+		}
+		values = append(values, value)
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[lokiv1.AnnotationAvailabilityZone] = strings.Join(values, "_")
+
+	if err := k.Patch(ctx, pod, patch); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return kverrors.Wrap(err, "failed to annotate pod with availability zone", "name", pod.Name, "namespace", pod.Namespace)
+	}
+
+	ll.Info("annotated pod with availability zone", "zone", pod.Annotations[lokiv1.AnnotationAvailabilityZone])
+
+	return nil
+}
@@ -13,7 +13,7 @@ func BuildAll(opts Options) ([]client.Object, error) {
 
 	sa := BuildServiceAccount(opts)
 
-	cm, sha1C, mapErr := LokiConfigMap(opts)
+	cm, rcm, sha1C, mapErr := LokiConfigMap(opts)
 	if mapErr != nil {
 		return nil, mapErr
 	}
@@ -49,7 +49,34 @@ func BuildAll(opts Options) ([]client.Object, error) {
 		return nil, err
 	}
 
+	if rulerEnabled(opts.Stack) {
+		rulerObjs, err := BuildRuler(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, rulerObjs...)
+	}
+
+	if opts.Stack.Tenants != nil {
+		gatewayObjs, err := BuildGateway(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, gatewayObjs...)
+	}
+
+	if opts.ObjectStorage.SharedStore == lokiv1.ObjectStorageSecretFileSystem {
+		res = append(res, NewFileSystemPersistentVolumeClaim(opts))
+	}
+
+	if opts.ObjectStorage.MinIO != nil {
+		res = append(res, BuildMinIO(opts)...)
+	}
+
 	res = append(res, cm)
+	res = append(res, rcm)
 	res = append(res, sa)
 	res = append(res, distributorObjs...)
 	res = append(res, ingesterObjs...)
@@ -74,8 +101,41 @@ func ApplyDefaultSettings(opts *Options) error {
 		return kverrors.Wrap(err, "failed merging stack user options", "name", opts.Name)
 	}
 
+	// A user-provided replication spec replaces the default one, keep the default factor if unset.
+	if spec.Replication != nil && spec.Replication.Factor == 0 {
+		if defaults := internal.StackSizeTable[opts.Stack.Size].Replication; defaults != nil {
+			spec.Replication.Factor = defaults.Factor
+		}
+	}
+
+	// Likewise a user-provided ingester spec replaces the default WAL settings, keep the unset ones.
+	if spec.Ingester != nil && spec.Ingester.WAL != nil && spec.Ingester.WAL.Enabled {
+		if defaults := internal.StackSizeTable[opts.Stack.Size].Ingester; defaults != nil && defaults.WAL != nil {
+			wal := spec.Ingester.WAL
+			if wal.ReplayMemoryCeiling == nil && defaults.WAL.ReplayMemoryCeiling != nil {
+				q := defaults.WAL.ReplayMemoryCeiling.DeepCopy()
+				wal.ReplayMemoryCeiling = &q
+			}
+			if wal.CheckpointDuration == "" {
+				wal.CheckpointDuration = defaults.WAL.CheckpointDuration
+			}
+		}
+	}
+
+	// A user-provided limits spec replaces the default global limits, keep the unset ones.
+	if defaults := internal.StackSizeTable[opts.Stack.Size].Limits; defaults != nil && defaults.Global != nil && spec.Limits != nil {
+		if spec.Limits.Global == nil {
+			spec.Limits.Global = &lokiv1.LimitsTemplateSpec{}
+		}
+
+		if err := mergo.Merge(spec.Limits.Global, defaults.Global.DeepCopy()); err != nil {
+			return kverrors.Wrap(err, "failed merging default global limits", "name", opts.Name)
+		}
+	}
+
 	opts.ResourceRequirements = internal.ResourceRequirementsTable[opts.Stack.Size]
 	opts.Stack = *spec
+	opts.Timeouts = defaultTimeoutConfig
 
 	return nil
 }
@@ -0,0 +1,202 @@
+package manifests
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/config"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+)
+
+func testBuildOptions(spec lokiv1.LokiStackSpec) Options {
+	return Options{
+		Name:      "test",
+		Namespace: "test-ns",
+		Image:     DefaultContainerImage,
+		Stack:     spec,
+		ObjectStorage: storage.Options{
+			SharedStore: lokiv1.ObjectStorageSecretS3,
+			SecretName:  "test-storage",
+			S3: &storage.S3StorageConfig{
+				Endpoint: "http://minio:9000",
+				Buckets:  "loki",
+			},
+			Schemas: []lokiv1.ObjectStorageSchema{
+				{
+					Version:       lokiv1.ObjectStorageSchemaV13,
+					EffectiveDate: "2024-01-01",
+				},
+			},
+		},
+	}
+}
+
+// renderLokiConfig applies the default settings, builds all manifests and
+// returns the static and the runtime Loki config.
+func renderLokiConfig(t *testing.T, spec lokiv1.LokiStackSpec) (string, string) {
+	t.Helper()
+
+	opts := testBuildOptions(spec)
+	if err := ApplyDefaultSettings(&opts); err != nil {
+		t.Fatalf("failed to apply default settings: %s", err)
+	}
+
+	objs, err := BuildAll(opts)
+	if err != nil {
+		t.Fatalf("failed to build manifests: %s", err)
+	}
+
+	var cfg, runtimeCfg string
+	for _, obj := range objs {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok {
+			continue
+		}
+
+		switch cm.Name {
+		case lokiConfigMapName(opts.Name):
+			cfg = cm.Data[config.LokiConfigFileName]
+		case lokiRuntimeConfigMapName(opts.Name):
+			runtimeCfg = cm.Data[config.LokiRuntimeConfigFileName]
+		}
+	}
+
+	if cfg == "" || runtimeCfg == "" {
+		t.Fatalf("missing loki config maps")
+	}
+
+	return cfg, runtimeCfg
+}
+
+func TestApplyDefaultSettings_KeepsGlobalLimitsForTenantLimits(t *testing.T) {
+	spec := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Tenants: map[string]lokiv1.PerTenantLimitsTemplateSpec{
+				"application": {
+					IngestionLimits: &lokiv1.IngestionLimitSpec{
+						IngestionRate: 10,
+					},
+				},
+			},
+		},
+	}
+
+	opts := testBuildOptions(spec)
+	if err := ApplyDefaultSettings(&opts); err != nil {
+		t.Fatalf("failed to apply default settings: %s", err)
+	}
+
+	want := internal.StackSizeTable[lokiv1.SizeOneXSmall].Limits.Global
+	got := opts.Stack.Limits.Global
+	if got == nil || got.IngestionLimits == nil || got.QueryLimits == nil {
+		t.Fatalf("missing default global limits: %#v", got)
+	}
+	if got.IngestionLimits.IngestionRate != want.IngestionLimits.IngestionRate {
+		t.Errorf("got global ingestion rate %d, want %d", got.IngestionLimits.IngestionRate, want.IngestionLimits.IngestionRate)
+	}
+	if got.QueryLimits.QueryTimeout != want.QueryLimits.QueryTimeout {
+		t.Errorf("got global query timeout %q, want %q", got.QueryLimits.QueryTimeout, want.QueryLimits.QueryTimeout)
+	}
+	if _, ok := opts.Stack.Limits.Tenants["application"]; !ok {
+		t.Errorf("missing tenant limits")
+	}
+}
+
+func TestApplyDefaultSettings_MergesPartialGlobalLimits(t *testing.T) {
+	spec := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Global: &lokiv1.LimitsTemplateSpec{
+				IngestionLimits: &lokiv1.IngestionLimitSpec{
+					IngestionRate: 42,
+				},
+			},
+		},
+	}
+
+	opts := testBuildOptions(spec)
+	if err := ApplyDefaultSettings(&opts); err != nil {
+		t.Fatalf("failed to apply default settings: %s", err)
+	}
+
+	want := internal.StackSizeTable[lokiv1.SizeOneXSmall].Limits.Global
+	got := opts.Stack.Limits.Global
+	if got.IngestionLimits.IngestionRate != 42 {
+		t.Errorf("got ingestion rate %d, want user value 42", got.IngestionLimits.IngestionRate)
+	}
+	if got.IngestionLimits.IngestionBurstSize != want.IngestionLimits.IngestionBurstSize {
+		t.Errorf("got ingestion burst size %d, want default %d", got.IngestionLimits.IngestionBurstSize, want.IngestionLimits.IngestionBurstSize)
+	}
+	if got.QueryLimits == nil {
+		t.Fatalf("missing default query limits")
+	}
+
+	// The size table must not be modified by merging the user spec.
+	if want.IngestionLimits.IngestionRate == 42 {
+		t.Errorf("default global limits modified")
+	}
+}
+
+func TestBuildAll_RendersTenantLimitsOnly(t *testing.T) {
+	spec := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Tenants: map[string]lokiv1.PerTenantLimitsTemplateSpec{
+				"application": {
+					IngestionLimits: &lokiv1.IngestionLimitSpec{
+						IngestionRate: 10,
+					},
+				},
+			},
+		},
+	}
+
+	cfg, runtimeCfg := renderLokiConfig(t, spec)
+
+	if !strings.Contains(cfg+runtimeCfg, "ingestion_rate_mb: 10") {
+		t.Errorf("missing tenant ingestion rate in rendered config")
+	}
+	if !strings.Contains(cfg+runtimeCfg, "max_query_series:") {
+		t.Errorf("missing default global query limits in rendered config")
+	}
+}
+
+func TestBuildAll_RendersRetentionOnly(t *testing.T) {
+	spec := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Global: &lokiv1.LimitsTemplateSpec{
+				Retention: &lokiv1.RetentionLimitSpec{
+					Days: 7,
+					Streams: []*lokiv1.RetentionStreamSpec{
+						{
+							Days:     1,
+							Priority: 1,
+							Selector: `{namespace="dev"}`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cfg, runtimeCfg := renderLokiConfig(t, spec)
+	all := cfg + runtimeCfg
+
+	for _, want := range []string{
+		"retention_enabled: true",
+		"retention_period: 7d",
+		`selector: '{namespace="dev"}'`,
+		"ingestion_rate_mb:",
+		"max_query_series:",
+	} {
+		if !strings.Contains(all, want) {
+			t.Errorf("missing %q in rendered config", want)
+		}
+	}
+}
@@ -42,7 +42,7 @@ func BuildCompactor(opts Options) ([]client.Object, error) {
 // NewCompactorStatefulSet creates a statefulset object for a compactor.
 func NewCompactorStatefulSet(opts Options) *appsv1.StatefulSet {
 	l := ComponentLabels(LabelCompactorComponent, opts.Name)
-	a := commonAnnotations(opts)
+	a := storageAnnotations(opts)
 	podSpec := corev1.PodSpec{
 		ServiceAccountName: opts.Name,
 		Volumes: []corev1.Volume{
@@ -57,6 +57,17 @@ func NewCompactorStatefulSet(opts Options) *appsv1.StatefulSet {
 					},
 				},
 			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
 		},
 		Containers: []corev1.Container{
 			{
@@ -69,7 +80,7 @@ func NewCompactorStatefulSet(opts Options) *appsv1.StatefulSet {
 				Args: []string{
 					"-target=compactor",
 					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
-					// fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiRuntimeConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
 					"-config.expand-env=true",
 				},
 				ReadinessProbe: lokiReadinessProbe(),
@@ -92,6 +103,11 @@ func NewCompactorStatefulSet(opts Options) *appsv1.StatefulSet {
 						ReadOnly:  false,
 						MountPath: config.LokiConfigMountDir,
 					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
 					{
 						Name:      storageVolumeName,
 						ReadOnly:  false,
@@ -3,44 +3,65 @@ package manifests
 import (
 	"crypto/sha1"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/config"
 )
 
-// LokiConfigMap creates the single configmap containing the loki configuration for the whole cluster
-func LokiConfigMap(opt Options) (*corev1.ConfigMap, string, error) {
+// LokiConfigMap creates the configmaps containing the loki configuration for the whole cluster.
+// The first configmap holds the static configuration and the second one the runtime
+// configuration. Only the static configuration is part of the returned hash, because
+// loki reloads the runtime configuration without restarting.
+func LokiConfigMap(opt Options) (*corev1.ConfigMap, *corev1.ConfigMap, string, error) {
 	cfg := ConfigOptions(opt)
 
-	c, err := config.Build(cfg)
+	c, rc, err := config.Build(cfg)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	s := sha1.New()
 	_, err = s.Write(c)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	sha1C := fmt.Sprintf("%x", s.Sum(nil))
 
-	return &corev1.ConfigMap{
+	cm := &corev1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ConfigMap",
 			APIVersion: corev1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   fmt.Sprintf("%s-config", opt.Name),
+			Name:   lokiConfigMapName(opt.Name),
 			Labels: commonLabels(opt.Name),
 		},
 		Data: map[string]string{
 			config.LokiConfigFileName: string(c),
 		},
-	}, sha1C, nil
+	}
+
+	rcm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   lokiRuntimeConfigMapName(opt.Name),
+			Labels: commonLabels(opt.Name),
+		},
+		Data: map[string]string{
+			config.LokiRuntimeConfigFileName: string(rc),
+		},
+	}
+
+	return cm, rcm, sha1C, nil
 }
 
 func ConfigOptions(opt Options) config.Options {
@@ -60,6 +81,21 @@ func ConfigOptions(opt Options) config.Options {
 		}
 	}
 
+	var (
+		evaluationInterval = defaultRulerEvaluationInterval
+		pollInterval       = defaultRulerPollInterval
+		amSpec             *lokiv1.AlertManagerSpec
+	)
+	if rc := opt.RulerConfig; rc != nil {
+		if rc.EvaluationInterval != "" {
+			evaluationInterval = string(rc.EvaluationInterval)
+		}
+		if rc.PollInterval != "" {
+			pollInterval = string(rc.PollInterval)
+		}
+		amSpec = rc.AlertManagerSpec
+	}
+
 	return config.Options{
 		Stack:     opt.Stack,
 		Namespace: opt.Namespace,
@@ -86,9 +122,172 @@ func ConfigOptions(opt Options) config.Options {
 		MaxConcurrent: config.MaxConcurrent{
 			AvailableQuerierCPUCores: int32(opt.ResourceRequirements.Querier.Requests.Cpu().Value()),
 		},
-		Shippers:      shippers,
-		ObjectStorage: opt.ObjectStorage,
-		HTTPTimeouts:  opt.Timeouts.Loki,
+		ReplicationFactor: replicationFactor(opt.Stack.Replication),
+		Shippers:          shippers,
+		ObjectStorage:     opt.ObjectStorage,
+		HTTPTimeouts:      opt.Timeouts.Loki,
+		WriteAheadLog: config.WriteAheadLog{
+			Directory: walDirectory,
+		},
+		IngesterWAL: ingesterWALConfig(opt.Stack.Ingester),
+		Ruler: config.Ruler{
+			Enabled:               rulerEnabled(opt.Stack),
+			RulesStorageDirectory: rulesStorageDirectory,
+			EvaluationInterval:    evaluationInterval,
+			PollInterval:          pollInterval,
+			RemoteWrite:           remoteWriteConfig(opt.Stack),
+			AlertManager:          alertManagerConfig(amSpec, ""),
+		},
+		Retention: retentionConfig(&opt.Stack),
+		Overrides: tenantOverrides(opt.Stack, opt.RulerConfig),
+	}
+}
+
+func rulerEnabled(spec lokiv1.LokiStackSpec) bool {
+	return spec.Rules != nil && spec.Rules.Enabled
+}
+
+// alertManagerConfig returns the alertmanager config of the ruler or, if the tenant
+// is set, of the tenant overrides.
+func alertManagerConfig(spec *lokiv1.AlertManagerSpec, tenant string) *config.AlertManagerConfig {
+	if spec == nil {
+		return nil
+	}
+
+	c := &config.AlertManagerConfig{
+		ExternalURL:    spec.ExternalURL,
+		ExternalLabels: spec.ExternalLabels,
+		Hosts:          strings.Join(spec.Endpoints, ","),
+	}
+
+	if d := spec.DiscoverySpec; d != nil {
+		c.EnableDiscovery = d.EnableSRV
+		c.RefreshInterval = string(d.RefreshInterval)
+	}
+
+	if q := spec.NotificationQueueSpec; q != nil {
+		c.QueueCapacity = q.Capacity
+		c.Timeout = string(q.Timeout)
+		c.ForOutageTolerance = string(q.ForOutageTolerance)
+		c.ForGracePeriod = string(q.ForGracePeriod)
+		c.ResendDelay = string(q.ResendDelay)
+	}
+
+	if cl := spec.Client; cl != nil {
+		n := &config.NotifierConfig{}
+		if tls := cl.TLS; tls != nil {
+			n.TLS = config.TLSConfig{
+				CertPath:           tls.CertPath,
+				KeyPath:            tls.KeyPath,
+				CAPath:             tls.CAPath,
+				ServerName:         tls.ServerName,
+				InsecureSkipVerify: tls.InsecureSkipVerify,
+			}
+		}
+		// The secrets are referenced by environment variables in the static config. The runtime
+		// config of the tenant overrides is not expanded, hence it references the mounted file.
+		if ba := cl.BasicAuth; ba != nil {
+			n.BasicAuth = config.BasicAuth{
+				Username: ptr.Deref(ba.Username, ""),
+			}
+			if ba.Password != nil && tenant == "" {
+				n.BasicAuth.Password = fmt.Sprintf("${%s}", envRulerAlertManagerPassword)
+			}
+		}
+		if ha := cl.HeaderAuth; ha != nil {
+			n.HeaderAuth = config.HeaderAuth{
+				Type:            ha.Type,
+				CredentialsFile: ha.CredentialsFile,
+			}
+			if ha.Credentials != nil {
+				if tenant == "" {
+					n.HeaderAuth.Credentials = ptr.To(fmt.Sprintf("${%s}", envRulerAlertManagerCredentials))
+				} else {
+					n.HeaderAuth.CredentialsFile = ptr.To(alertManagerCredentialsPath(tenant))
+				}
+			}
+		}
+		c.Notifier = n
+	}
+
+	return c
+}
+
+// tenantOverrides merges the per-tenant limits of the LokiStack with the
+// per-tenant ruler overrides of the RulerConfig into the runtime config overrides.
+func tenantOverrides(stack lokiv1.LokiStackSpec, rc *lokiv1.RulerConfigSpec) map[string]config.LokiOverrides {
+	overrides := map[string]config.LokiOverrides{}
+
+	if stack.Limits != nil {
+		for tenant, limits := range stack.Limits.Tenants {
+			overrides[tenant] = config.LokiOverrides{
+				Limits: limits,
+			}
+		}
+	}
+
+	if rc != nil {
+		for tenant, o := range rc.Overrides {
+			if o.AlertManagerOverrides == nil {
+				continue
+			}
+
+			to := overrides[tenant]
+			to.Ruler = config.RulerOverrides{
+				AlertManager: alertManagerConfig(o.AlertManagerOverrides, tenant),
+			}
+			overrides[tenant] = to
+		}
+	}
+
+	return overrides
+}
+
+// RemoteWriteEnabled returns true if the ruler is enabled and configured
+// to send recording rule samples to a remote write endpoint.
+func RemoteWriteEnabled(spec lokiv1.LokiStackSpec) bool {
+	return rulerEnabled(spec) &&
+		spec.Rules.RemoteWrite != nil &&
+		spec.Rules.RemoteWrite.Enabled &&
+		spec.Rules.RemoteWrite.Client != nil
+}
+
+func remoteWriteConfig(spec lokiv1.LokiStackSpec) *config.RemoteWriteConfig {
+	if !RemoteWriteEnabled(spec) {
+		return nil
+	}
+
+	rw := spec.Rules.RemoteWrite
+	c := config.RemoteWriteClientConfig{
+		Name:            rw.Client.Name,
+		URL:             rw.Client.URL,
+		RemoteTimeout:   string(rw.Client.Timeout),
+		Headers:         rw.Client.AdditionalHeaders,
+		FollowRedirects: rw.Client.FollowRedirects,
+	}
+
+	switch rw.Client.AuthorizationType {
+	case lokiv1.BasicAuthorization:
+		c.BasicAuth = &config.BasicAuth{
+			Username: fmt.Sprintf("${%s}", envRulerRemoteWriteUsername),
+			Password: fmt.Sprintf("${%s}", envRulerRemoteWritePassword),
+		}
+	case lokiv1.BearerAuthorization:
+		c.BearerToken = fmt.Sprintf("${%s}", envRulerRemoteWriteBearerToken)
+	}
+
+	return &config.RemoteWriteConfig{
+		RefreshPeriod: string(rw.RefreshPeriod),
+		Client:        c,
+	}
+}
+
+// retentionConfig returns the compactor retention config. Retention is always enabled in
+// the compactor, because it is required to process log deletion requests as well.
+// Without retention periods configured, no logs are deleted by retention.
+func retentionConfig(ls *lokiv1.LokiStackSpec) config.RetentionOptions {
+	return config.RetentionOptions{
+		DeleteWorkerCount: deleteWorkerCountMap[ls.Size],
 	}
 }
 
@@ -99,6 +298,31 @@ var deleteWorkerCountMap = map[lokiv1.LokiStackSizeType]uint{
 	lokiv1.SizeOneXMedium:     150,
 }
 
+func ingesterWALConfig(spec *lokiv1.IngesterSpec) config.IngesterWAL {
+	if spec == nil || spec.WAL == nil || !spec.WAL.Enabled {
+		return config.IngesterWAL{}
+	}
+
+	wal := config.IngesterWAL{
+		Enabled:            true,
+		Directory:          ingesterWALDirectory,
+		CheckpointDuration: spec.WAL.CheckpointDuration,
+	}
+	if q := spec.WAL.ReplayMemoryCeiling; q != nil {
+		wal.ReplayMemoryCeiling = q.Value()
+	}
+
+	return wal
+}
+
+func replicationFactor(replication *lokiv1.ReplicationSpec) int32 {
+	if replication == nil || replication.Factor < 1 {
+		return 1
+	}
+
+	return replication.Factor
+}
+
 func gossipRingConfig(stackName, stackNs string, spec *lokiv1.HashRingSpec, replication *lokiv1.ReplicationSpec) config.GossipRing {
 	var (
 		instanceAddr string
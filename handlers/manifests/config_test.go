@@ -0,0 +1,116 @@
+package manifests
+
+import (
+	"strings"
+	"testing"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+)
+
+func configHash(t *testing.T, spec lokiv1.LokiStackSpec) string {
+	t.Helper()
+
+	opts := testBuildOptions(spec)
+	if err := ApplyDefaultSettings(&opts); err != nil {
+		t.Fatalf("failed to apply default settings: %s", err)
+	}
+
+	_, _, sha1C, err := LokiConfigMap(opts)
+	if err != nil {
+		t.Fatalf("failed to build config maps: %s", err)
+	}
+
+	return sha1C
+}
+
+func TestLokiConfigMap_HashIncludesGlobalLimits(t *testing.T) {
+	base := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+	}
+	changed := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Global: &lokiv1.LimitsTemplateSpec{
+				IngestionLimits: &lokiv1.IngestionLimitSpec{
+					IngestionRate: 42,
+				},
+			},
+		},
+	}
+
+	if configHash(t, base) == configHash(t, changed) {
+		t.Errorf("config hash unchanged for global limits")
+	}
+}
+
+func TestLokiConfigMap_HashExcludesTenantLimits(t *testing.T) {
+	base := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+	}
+	changed := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Tenants: map[string]lokiv1.PerTenantLimitsTemplateSpec{
+				"application": {
+					IngestionLimits: &lokiv1.IngestionLimitSpec{
+						IngestionRate: 10,
+					},
+					QueryLimits: &lokiv1.PerTenantQueryLimitSpec{
+						QueryLimitSpec: lokiv1.QueryLimitSpec{
+							QueryTimeout: "5m",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if configHash(t, base) != configHash(t, changed) {
+		t.Errorf("config hash changed for tenant limits")
+	}
+}
+
+func TestLokiConfigMap_HashIncludesStaticSettings(t *testing.T) {
+	base := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+	}
+	changed := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Replication: &lokiv1.ReplicationSpec{
+			Factor: 3,
+		},
+	}
+
+	if configHash(t, base) == configHash(t, changed) {
+		t.Errorf("config hash unchanged for the replication factor")
+	}
+}
+
+func TestBuildAll_RendersLimitsByConfig(t *testing.T) {
+	spec := lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Limits: &lokiv1.LimitsSpec{
+			Global: &lokiv1.LimitsTemplateSpec{
+				IngestionLimits: &lokiv1.IngestionLimitSpec{
+					IngestionRate: 42,
+				},
+			},
+			Tenants: map[string]lokiv1.PerTenantLimitsTemplateSpec{
+				"application": {
+					IngestionLimits: &lokiv1.IngestionLimitSpec{
+						IngestionRate: 10,
+					},
+				},
+			},
+		},
+	}
+
+	cfg, runtimeCfg := renderLokiConfig(t, spec)
+
+	if !strings.Contains(cfg, "ingestion_rate_mb: 42") || strings.Contains(cfg, "ingestion_rate_mb: 10") {
+		t.Errorf("want only global limits in static config:\n%s", cfg)
+	}
+	if !strings.Contains(runtimeCfg, "application:") || !strings.Contains(runtimeCfg, "ingestion_rate_mb: 10") || strings.Contains(runtimeCfg, "ingestion_rate_mb: 42") {
+		t.Errorf("want only tenant limits in runtime config:\n%s", runtimeCfg)
+	}
+}
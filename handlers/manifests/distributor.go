@@ -57,6 +57,17 @@ func NewDistributorDeployment(opts Options) *appsv1.Deployment {
 					},
 				},
 			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
 		},
 		Containers: []corev1.Container{
 			{
@@ -69,6 +80,7 @@ func NewDistributorDeployment(opts Options) *appsv1.Deployment {
 				Args: []string{
 					"-target=distributor",
 					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
 					"-config.expand-env=true",
 				},
 				ReadinessProbe: lokiReadinessProbe(),
@@ -96,6 +108,11 @@ func NewDistributorDeployment(opts Options) *appsv1.Deployment {
 						ReadOnly:  false,
 						MountPath: config.LokiConfigMountDir,
 					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
 				},
 				TerminationMessagePath:   "/dev/termination-log",
 				TerminationMessagePolicy: "File",
@@ -0,0 +1,37 @@
+package manifests
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+)
+
+// NewFileSystemPersistentVolumeClaim creates the persistent volume claim shared
+// by all components when using filesystem storage.
+func NewFileSystemPersistentVolumeClaim(opts Options) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   storage.FileSystemClaimName(opts.Name),
+			Labels: commonLabels(opts.Name),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: map[corev1.ResourceName]resource.Quantity{
+					corev1.ResourceStorage: opts.ResourceRequirements.FileSystem.PVCSize,
+				},
+			},
+			StorageClassName: ptr.To(opts.Stack.StorageClassName),
+			VolumeMode:       &volumeFileSystemMode,
+		},
+	}
+}
@@ -0,0 +1,453 @@
+package manifests
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"path"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/gateway"
+)
+
+// BuildGateway returns a list of k8s objects for Loki Stack Gateway
+func BuildGateway(opts Options) ([]client.Object, error) {
+	cm, secret, sha1C, err := gatewayConfigObjs(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := NewGatewayDeployment(opts, sha1C)
+
+	if err := configureProxyEnv(&deployment.Spec.Template.Spec, opts); err != nil {
+		return nil, err
+	}
+
+	if err := configureReplication(&deployment.Spec.Template, opts.Stack.Replication, LabelGatewayComponent, opts.Name); err != nil {
+		return nil, err
+	}
+
+	objs := []client.Object{cm}
+	if secret != nil {
+		objs = append(objs, secret)
+	}
+
+	return append(objs,
+		deployment,
+		NewGatewayHTTPService(opts),
+		NewGatewayPodDisruptionBudget(opts),
+	), nil
+}
+
+// NewGatewayDeployment creates a deployment object for a lokiStack-gateway
+func NewGatewayDeployment(opts Options, sha1C string) *appsv1.Deployment {
+	l := ComponentLabels(LabelGatewayComponent, opts.Name)
+	// The gateway does not read the loki config, hence it rolls only on gateway config changes.
+	a := map[string]string{
+		AnnotationLokiGatewayConfigHash: sha1C,
+	}
+
+	var container corev1.Container
+	if opts.Stack.Tenants.Mode == lokiv1.Passthrough {
+		container = gatewayPassthroughContainer(opts)
+	} else {
+		container = gatewayContainer(opts)
+	}
+
+	podSpec := corev1.PodSpec{
+		ServiceAccountName: opts.Name,
+		Volumes: []corev1.Volume{
+			{
+				Name: "gateway-config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: GatewayName(opts.Name),
+						},
+					},
+				},
+			},
+		},
+		Containers: []corev1.Container{container},
+	}
+
+	if opts.Stack.Tenants.Mode != lokiv1.Passthrough {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: "tenants",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: GatewayName(opts.Name),
+				},
+			},
+		})
+	}
+
+	if opts.Stack.Template != nil && opts.Stack.Template.Gateway != nil {
+		podSpec.Tolerations = opts.Stack.Template.Gateway.Tolerations
+		podSpec.NodeSelector = opts.Stack.Template.Gateway.NodeSelector
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   GatewayName(opts.Name),
+			Labels: l,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(opts.Stack.Template.Gateway.Replicas),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: l,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        GatewayName(opts.Name),
+					Labels:      l,
+					Annotations: a,
+				},
+				Spec: podSpec,
+			},
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+			},
+		},
+	}
+}
+
+// gatewayContainer returns the observatorium api container authenticating
+// tenants via OIDC and authorizing them with the rendered rbac configuration.
+func gatewayContainer(opts Options) corev1.Container {
+	c := corev1.Container{
+		Name:  gatewayContainerName,
+		Image: opts.GatewayImage,
+		Resources: corev1.ResourceRequirements{
+			Limits:   opts.ResourceRequirements.Gateway.Limits,
+			Requests: opts.ResourceRequirements.Gateway.Requests,
+		},
+		Args: []string{
+			fmt.Sprintf("--debug.name=%s", LabelGatewayComponent),
+			fmt.Sprintf("--web.listen=0.0.0.0:%d", gatewayHTTPPort),
+			fmt.Sprintf("--web.internal.listen=0.0.0.0:%d", gatewayInternalPort),
+			fmt.Sprintf("--web.healthchecks.url=http://localhost:%d", gatewayHTTPPort),
+			"--log.level=warn",
+			fmt.Sprintf("--logs.read.endpoint=http://%s:%d", fqdn(serviceNameQueryFrontendHTTP(opts.Name), opts.Namespace), httpPort),
+			fmt.Sprintf("--logs.tail.endpoint=http://%s:%d", fqdn(serviceNameQueryFrontendHTTP(opts.Name), opts.Namespace), httpPort),
+			fmt.Sprintf("--logs.write.endpoint=http://%s:%d", fqdn(serviceNameDistributorHTTP(opts.Name), opts.Namespace), httpPort),
+			fmt.Sprintf("--logs.write-timeout=%s", opts.Timeouts.Gateway.UpstreamWriteTimeout),
+			fmt.Sprintf("--rbac.config=%s", path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayRbacFileName)),
+			fmt.Sprintf("--tenants.config=%s", path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayTenantFileName)),
+			fmt.Sprintf("--server.read-timeout=%s", opts.Timeouts.Gateway.ReadTimeout),
+			fmt.Sprintf("--server.write-timeout=%s", opts.Timeouts.Gateway.WriteTimeout),
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          gatewayInternalPortName,
+				ContainerPort: gatewayInternalPort,
+				Protocol:      protocolTCP,
+			},
+			{
+				Name:          gatewayHTTPPortName,
+				ContainerPort: gatewayHTTPPort,
+				Protocol:      protocolTCP,
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "gateway-config",
+				ReadOnly:  true,
+				MountPath: path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayRbacFileName),
+				SubPath:   gateway.LokiGatewayRbacFileName,
+			},
+			{
+				Name:      "tenants",
+				ReadOnly:  true,
+				MountPath: path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayTenantFileName),
+				SubPath:   gateway.LokiGatewayTenantFileName,
+			},
+		},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/live",
+					Port:   intstr.FromInt(gatewayInternalPort),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			TimeoutSeconds:   2,
+			PeriodSeconds:    30,
+			FailureThreshold: 10,
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/ready",
+					Port:   intstr.FromInt(gatewayInternalPort),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			TimeoutSeconds:   1,
+			PeriodSeconds:    5,
+			FailureThreshold: 12,
+		},
+		TerminationMessagePath:   "/dev/termination-log",
+		TerminationMessagePolicy: "File",
+		ImagePullPolicy:          "IfNotPresent",
+	}
+
+	if rulerEnabled(opts.Stack) {
+		c.Args = append(c.Args,
+			fmt.Sprintf("--logs.rules.endpoint=http://%s:%d", fqdn(serviceNameRulerHTTP(opts.Name), opts.Namespace), httpPort),
+			"--logs.rules.read-only=true",
+		)
+	}
+
+	return c
+}
+
+// gatewayPassthroughContainer returns the nginx container routing requests
+// to the distributor and query-frontend without authentication.
+func gatewayPassthroughContainer(opts Options) corev1.Container {
+	probe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   "/",
+				Port:   intstr.FromInt(gatewayHTTPPort),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		TimeoutSeconds:   1,
+		PeriodSeconds:    10,
+		FailureThreshold: 3,
+	}
+
+	return corev1.Container{
+		Name:  gatewayContainerName,
+		Image: DefaultLokiStackGatewayPassthroughImage,
+		Resources: corev1.ResourceRequirements{
+			Limits:   opts.ResourceRequirements.Gateway.Limits,
+			Requests: opts.ResourceRequirements.Gateway.Requests,
+		},
+		Command: []string{
+			"nginx",
+			"-g",
+			"daemon off;",
+			"-c",
+			path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayNginxFileName),
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          gatewayHTTPPortName,
+				ContainerPort: gatewayHTTPPort,
+				Protocol:      protocolTCP,
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "gateway-config",
+				ReadOnly:  true,
+				MountPath: path.Join(gateway.LokiGatewayMountDir, gateway.LokiGatewayNginxFileName),
+				SubPath:   gateway.LokiGatewayNginxFileName,
+			},
+		},
+		LivenessProbe:            probe,
+		ReadinessProbe:           probe,
+		TerminationMessagePath:   "/dev/termination-log",
+		TerminationMessagePolicy: "File",
+		ImagePullPolicy:          "IfNotPresent",
+	}
+}
+
+// NewGatewayHTTPService creates a k8s service for the lokistack-gateway HTTP endpoint
+func NewGatewayHTTPService(opts Options) *corev1.Service {
+	serviceName := serviceNameGatewayHTTP(opts.Name)
+	labels := ComponentLabels(LabelGatewayComponent, opts.Name)
+
+	ports := []corev1.ServicePort{
+		{
+			Name:       gatewayHTTPPortName,
+			Port:       gatewayHTTPPort,
+			Protocol:   protocolTCP,
+			TargetPort: intstr.IntOrString{IntVal: gatewayHTTPPort},
+		},
+	}
+	if opts.Stack.Tenants.Mode != lokiv1.Passthrough {
+		ports = append(ports, corev1.ServicePort{
+			Name:       gatewayInternalPortName,
+			Port:       gatewayInternalPort,
+			Protocol:   protocolTCP,
+			TargetPort: intstr.IntOrString{IntVal: gatewayInternalPort},
+		})
+	}
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceName,
+			Labels: labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    ports,
+			Selector: labels,
+		},
+	}
+}
+
+// NewGatewayPodDisruptionBudget returns a PodDisruptionBudget for the LokiStack
+// Gateway pods.
+func NewGatewayPodDisruptionBudget(opts Options) *policyv1.PodDisruptionBudget {
+	l := ComponentLabels(LabelGatewayComponent, opts.Name)
+	mu := intstr.FromInt(1)
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: policyv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:    l,
+			Name:      GatewayName(opts.Name),
+			Namespace: opts.Namespace,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: l,
+			},
+			MinAvailable: &mu,
+		},
+	}
+}
+
+// gatewayConfigObjs creates the configmap holding the rbac (or nginx) configuration and
+// the secret holding the tenants configuration for the lokistack-gateway. The secret
+// is nil in passthrough mode.
+func gatewayConfigObjs(opts Options) (*corev1.ConfigMap, *corev1.Secret, string, error) {
+	cfg := gatewayConfigOptions(opts)
+	rbacConfig, tenantsConfig, nginxConfig, err := gateway.Build(cfg)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	s := sha1.New()
+	for _, c := range [][]byte{rbacConfig, tenantsConfig, nginxConfig} {
+		if _, err := s.Write(c); err != nil {
+			return nil, nil, "", err
+		}
+	}
+	sha1C := fmt.Sprintf("%x", s.Sum(nil))
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   GatewayName(opts.Name),
+			Labels: ComponentLabels(LabelGatewayComponent, opts.Name),
+		},
+	}
+
+	if opts.Stack.Tenants.Mode == lokiv1.Passthrough {
+		cm.Data = map[string]string{
+			gateway.LokiGatewayNginxFileName: string(nginxConfig),
+		}
+		return cm, nil, sha1C, nil
+	}
+
+	cm.Data = map[string]string{
+		gateway.LokiGatewayRbacFileName: string(rbacConfig),
+	}
+
+	return cm, &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   GatewayName(opts.Name),
+			Labels: ComponentLabels(LabelGatewayComponent, opts.Name),
+		},
+		Data: map[string][]byte{
+			gateway.LokiGatewayTenantFileName: tenantsConfig,
+		},
+	}, sha1C, nil
+}
+
+func gatewayConfigOptions(opts Options) gateway.Options {
+	tenants := opts.Stack.Tenants
+
+	secrets := make([]*gateway.Secret, 0, len(opts.Tenants.Secrets))
+	for _, s := range opts.Tenants.Secrets {
+		secrets = append(secrets, &gateway.Secret{
+			TenantName:   s.TenantName,
+			ClientID:     s.ClientID,
+			ClientSecret: s.ClientSecret,
+		})
+	}
+
+	cfg := gateway.Options{
+		Mode:           tenants.Mode,
+		Authentication: tenants.Authentication,
+		TenantSecrets:  secrets,
+		Distributor: gateway.Address{
+			FQDN: fqdn(serviceNameDistributorHTTP(opts.Name), opts.Namespace),
+			Port: httpPort,
+		},
+		QueryFrontend: gateway.Address{
+			FQDN: fqdn(serviceNameQueryFrontendHTTP(opts.Name), opts.Namespace),
+			Port: httpPort,
+		},
+	}
+
+	switch tenants.Mode {
+	case lokiv1.Static:
+		if tenants.Authorization != nil {
+			cfg.Roles = tenants.Authorization.Roles
+			cfg.RoleBindings = tenants.Authorization.RoleBindings
+		}
+	case lokiv1.OIDC:
+		cfg.Roles, cfg.RoleBindings = tenantGroupRBAC(tenants.Authentication)
+	}
+
+	return cfg
+}
+
+// tenantGroupRBAC grants read and write access on each tenant
+// to the members of the group named like the tenant.
+func tenantGroupRBAC(authn []lokiv1.AuthenticationSpec) ([]lokiv1.RoleSpec, []lokiv1.RoleBindingsSpec) {
+	roles := make([]lokiv1.RoleSpec, 0, len(authn))
+	bindings := make([]lokiv1.RoleBindingsSpec, 0, len(authn))
+
+	for _, a := range authn {
+		name := fmt.Sprintf("%s-read-write", a.TenantName)
+		roles = append(roles, lokiv1.RoleSpec{
+			Name:        name,
+			Resources:   []string{"logs"},
+			Tenants:     []string{a.TenantName},
+			Permissions: []lokiv1.PermissionType{lokiv1.Read, lokiv1.Write},
+		})
+		bindings = append(bindings, lokiv1.RoleBindingsSpec{
+			Name:  name,
+			Roles: []string{name},
+			Subjects: []lokiv1.Subject{
+				{
+					Name: a.TenantName,
+					Kind: lokiv1.Group,
+				},
+			},
+		})
+	}
+
+	return roles, bindings
+}
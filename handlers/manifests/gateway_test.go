@@ -0,0 +1,28 @@
+package manifests
+
+import (
+	"testing"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+)
+
+func TestNewGatewayDeployment_IgnoresLokiConfigHash(t *testing.T) {
+	opts := testBuildOptions(lokiv1.LokiStackSpec{
+		Size: lokiv1.SizeOneXSmall,
+		Tenants: &lokiv1.TenantsSpec{
+			Mode: lokiv1.Static,
+		},
+	})
+	if err := ApplyDefaultSettings(&opts); err != nil {
+		t.Fatalf("failed to apply default settings: %s", err)
+	}
+	opts.ConfigSHA1 = "loki-config"
+
+	a := NewGatewayDeployment(opts, "gateway-config").Spec.Template.Annotations
+	if _, ok := a[AnnotationLokiConfigHash]; ok {
+		t.Errorf("gateway pod template annotated with the loki config hash: %v", a)
+	}
+	if a[AnnotationLokiGatewayConfigHash] != "gateway-config" {
+		t.Errorf("got gateway config hash %q, want %q", a[AnnotationLokiGatewayConfigHash], "gateway-config")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"path"
 
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/config"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -20,6 +21,10 @@ import (
 func BuildIndexGateway(opts Options) ([]client.Object, error) {
 	statefulSet := NewIndexGatewayStatefulSet(opts)
 
+	if err := storage.ConfigureStatefulSet(statefulSet, opts.ObjectStorage); err != nil {
+		return nil, err
+	}
+
 	if err := configureHashRingEnv(&statefulSet.Spec.Template.Spec, opts); err != nil {
 		return nil, err
 	}
@@ -43,7 +48,7 @@ func BuildIndexGateway(opts Options) ([]client.Object, error) {
 // NewIndexGatewayStatefulSet creates a statefulset object for an index-gateway
 func NewIndexGatewayStatefulSet(opts Options) *appsv1.StatefulSet {
 	l := ComponentLabels(LabelIndexGatewayComponent, opts.Name)
-	a := commonAnnotations(opts)
+	a := storageAnnotations(opts)
 	podSpec := corev1.PodSpec{
 		ServiceAccountName: opts.Name,
 		Volumes: []corev1.Volume{
@@ -58,6 +63,17 @@ func NewIndexGatewayStatefulSet(opts Options) *appsv1.StatefulSet {
 					},
 				},
 			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
 		},
 		Containers: []corev1.Container{
 			{
@@ -70,7 +86,7 @@ func NewIndexGatewayStatefulSet(opts Options) *appsv1.StatefulSet {
 				Args: []string{
 					"-target=index-gateway",
 					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
-					// fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiRuntimeConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
 					"-config.expand-env=true",
 				},
 				ReadinessProbe: lokiReadinessProbe(),
@@ -93,6 +109,11 @@ func NewIndexGatewayStatefulSet(opts Options) *appsv1.StatefulSet {
 						ReadOnly:  false,
 						MountPath: config.LokiConfigMountDir,
 					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
 					{
 						Name:      storageVolumeName,
 						ReadOnly:  false,
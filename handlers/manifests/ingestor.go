@@ -34,22 +34,37 @@ func BuildIngester(opts Options) ([]client.Object, error) {
 		return nil, err
 	}
 
-	if err := configureReplication(&statefulSet.Spec.Template, opts.Stack.Replication, LabelIngesterComponent, opts.Name); err != nil {
-		return nil, err
-	}
-
-	return []client.Object{
-		statefulSet,
+	services := []client.Object{
 		NewIngesterGRPCService(opts),
 		NewIngesterHTTPService(opts),
 		newIngesterPodDisruptionBudget(opts),
-	}, nil
+	}
+
+	zones := IngesterZones(opts.Stack.Replication)
+	if len(zones) == 0 {
+		if err := configureReplication(&statefulSet.Spec.Template, opts.Stack.Replication, LabelIngesterComponent, opts.Name); err != nil {
+			return nil, err
+		}
+
+		return append([]client.Object{statefulSet}, services...), nil
+	}
+
+	objs := make([]client.Object, 0, len(zones)+len(services))
+	topologyKey := opts.Stack.Replication.Zones[0].TopologyKey
+	for i, zone := range zones {
+		zoneSet := statefulSet.DeepCopy()
+		replicas := zoneReplicas(opts.Stack.Template.Ingester.Replicas, len(zones), i)
+		configureIngesterZone(zoneSet, opts.Name, topologyKey, zone, replicas)
+		objs = append(objs, zoneSet)
+	}
+
+	return append(objs, services...), nil
 }
 
 // NewIngesterStatefulSet creates a deployment object for an ingester
 func NewIngesterStatefulSet(opts Options) *appsv1.StatefulSet {
 	l := ComponentLabels(LabelIngesterComponent, opts.Name)
-	a := commonAnnotations(opts)
+	a := storageAnnotations(opts)
 	podSpec := corev1.PodSpec{
 		ServiceAccountName: opts.Name,
 		Volumes: []corev1.Volume{
@@ -64,6 +79,17 @@ func NewIngesterStatefulSet(opts Options) *appsv1.StatefulSet {
 					},
 				},
 			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
 		},
 		Containers: []corev1.Container{
 			{
@@ -76,7 +102,7 @@ func NewIngesterStatefulSet(opts Options) *appsv1.StatefulSet {
 				Args: []string{
 					"-target=ingester",
 					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
-					// fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiRuntimeConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
 					"-config.expand-env=true",
 				},
 				ReadinessProbe: lokiReadinessProbe(),
@@ -104,6 +130,11 @@ func NewIngesterStatefulSet(opts Options) *appsv1.StatefulSet {
 						ReadOnly:  false,
 						MountPath: config.LokiConfigMountDir,
 					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
 					{
 						Name:      storageVolumeName,
 						ReadOnly:  false,
@@ -16,6 +16,8 @@ const (
 	LokiRuntimeConfigFileName = "runtime-config.yaml"
 	// LokiConfigMountDir is the path that is mounted from the configmap
 	LokiConfigMountDir = "/etc/loki/config"
+	// LokiRuntimeConfigMountDir is the path that is mounted from the runtime configmap
+	LokiRuntimeConfigMountDir = "/etc/loki/runtime-config"
 )
 
 var (
@@ -31,28 +33,26 @@ var (
 )
 
 // Build builds a loki stack configuration files
-func Build(opts Options) ([]byte, error) {
+func Build(opts Options) ([]byte, []byte, error) {
 	// Build loki config yaml
 	w := bytes.NewBuffer(nil)
 	err := lokiConfigYAMLTmpl.Execute(w, opts)
 	if err != nil {
-		return nil, kverrors.Wrap(err, "failed to create loki configuration")
+		return nil, nil, kverrors.Wrap(err, "failed to create loki configuration")
 	}
 	cfg, err := io.ReadAll(w)
 	if err != nil {
-		return nil, kverrors.Wrap(err, "failed to read configuration from buffer")
+		return nil, nil, kverrors.Wrap(err, "failed to read configuration from buffer")
 	}
-	// // Build loki runtime config yaml
-	// // TODO: FOUNDED ERROR!!!!
-	// w = bytes.NewBuffer(nil)
-	// err = lokiRuntimeConfigYAMLTmpl.Execute(w, opts)
-	// if err != nil {
-	// 	// return nil, nil, kverrors.Wrap(err, "failed to create loki runtime configuration")
-	// 	return nil, nil, err
-	// }
-	// rcfg, err := io.ReadAll(w)
-	// if err != nil {
-	// 	return nil, nil, kverrors.Wrap(err, "failed to read configuration from buffer")
-	// }
-	return cfg, nil
+	// Build loki runtime config yaml
+	w = bytes.NewBuffer(nil)
+	err = lokiRuntimeConfigYAMLTmpl.Execute(w, opts)
+	if err != nil {
+		return nil, nil, kverrors.Wrap(err, "failed to create loki runtime configuration")
+	}
+	rcfg, err := io.ReadAll(w)
+	if err != nil {
+		return nil, nil, kverrors.Wrap(err, "failed to read configuration from buffer")
+	}
+	return cfg, rcfg, nil
 }
@@ -20,12 +20,35 @@ type Options struct {
 	IndexGateway          Address
 	StorageDirectory      string
 	MaxConcurrent         MaxConcurrent
+	ReplicationFactor     int32
 	EnableRemoteReporting bool
 	Shippers              []string
 
 	ObjectStorage storage.Options
 
 	HTTPTimeouts HTTPTimeoutConfig
+
+	WriteAheadLog WriteAheadLog
+	IngesterWAL   IngesterWAL
+	Ruler         Ruler
+	Retention     RetentionOptions
+	Overrides     map[string]LokiOverrides
+}
+
+// RetentionOptions configures the compactor to apply retention and log deletion requests
+type RetentionOptions struct {
+	DeleteWorkerCount uint
+}
+
+// LokiOverrides defines the runtime overrides applied per tenant
+type LokiOverrides struct {
+	Limits lokiv1.PerTenantLimitsTemplateSpec
+	Ruler  RulerOverrides
+}
+
+// RulerOverrides defines the ruler runtime overrides applied per tenant
+type RulerOverrides struct {
+	AlertManager *AlertManagerConfig
 }
 
 // Address FQDN and port for a k8s service.
@@ -45,6 +68,97 @@ type HTTPTimeoutConfig struct {
 	WriteTimeout time.Duration
 }
 
+// WriteAheadLog configuration
+type WriteAheadLog struct {
+	Directory string
+}
+
+// IngesterWAL configures the write-ahead log of the ingesters
+type IngesterWAL struct {
+	Enabled             bool
+	Directory           string
+	ReplayMemoryCeiling int64
+	CheckpointDuration  string
+}
+
+// Ruler configuration
+type Ruler struct {
+	Enabled               bool
+	RulesStorageDirectory string
+	EvaluationInterval    string
+	PollInterval          string
+	RemoteWrite           *RemoteWriteConfig
+	AlertManager          *AlertManagerConfig
+}
+
+// AlertManagerConfig for ruler alertmanager config
+type AlertManagerConfig struct {
+	// ExternalURL, ExternalLabels and the for/resend settings
+	// are only applicable to the global ruler configuration.
+	ExternalURL    string
+	ExternalLabels map[string]string
+	Hosts          string
+
+	EnableDiscovery bool
+	RefreshInterval string
+
+	QueueCapacity      int32
+	Timeout            string
+	ForOutageTolerance string
+	ForGracePeriod     string
+	ResendDelay        string
+
+	Notifier *NotifierConfig
+}
+
+// NotifierConfig for the alertmanager client
+type NotifierConfig struct {
+	TLS        TLSConfig
+	BasicAuth  BasicAuth
+	HeaderAuth HeaderAuth
+}
+
+// TLSConfig for the alertmanager client
+type TLSConfig struct {
+	CertPath           *string
+	KeyPath            *string
+	CAPath             *string
+	ServerName         *string
+	InsecureSkipVerify *bool
+}
+
+// HeaderAuth for HTTP header authentication
+type HeaderAuth struct {
+	Type            *string
+	Credentials     *string
+	CredentialsFile *string
+}
+
+// RemoteWriteConfig for ruler remote write config
+type RemoteWriteConfig struct {
+	RefreshPeriod string
+	Client        RemoteWriteClientConfig
+}
+
+// RemoteWriteClientConfig for ruler remote write client config
+type RemoteWriteClientConfig struct {
+	Name            string
+	URL             string
+	RemoteTimeout   string
+	Headers         map[string]string
+	FollowRedirects bool
+	// BasicAuth and BearerToken are optional and
+	// reference the credentials exposed as environment variables.
+	BasicAuth   *BasicAuth
+	BearerToken string
+}
+
+// BasicAuth for HTTP basic authentication
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
 // MaxConcurrent for concurrent query processing.
 type MaxConcurrent struct {
 	AvailableQuerierCPUCores int32
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"bytes"
+	"embed"
+	"io"
+	"text/template"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+)
+
+const (
+	// LokiGatewayTenantFileName is the name of the tenant config file in the secret
+	LokiGatewayTenantFileName = "tenants.yaml"
+	// LokiGatewayRbacFileName is the name of the rbac config file in the configmap
+	LokiGatewayRbacFileName = "rbac.yaml"
+	// LokiGatewayNginxFileName is the name of the nginx config file used in passthrough mode
+	LokiGatewayNginxFileName = "nginx.conf"
+	// LokiGatewayMountDir is the path that is mounted from the configmap and secret
+	LokiGatewayMountDir = "/etc/lokistack-gateway"
+)
+
+var (
+	//go:embed gateway-rbac.yaml
+	lokiGatewayRbacYAMLTmplFile embed.FS
+
+	//go:embed gateway-tenants.yaml
+	lokiGatewayTenantsYAMLTmplFile embed.FS
+
+	//go:embed nginx.conf
+	lokiGatewayNginxTmplFile embed.FS
+
+	lokiGatewayRbacYAMLTmpl = template.Must(template.ParseFS(lokiGatewayRbacYAMLTmplFile, "gateway-rbac.yaml"))
+
+	lokiGatewayTenantsYAMLTmpl = template.Must(template.ParseFS(lokiGatewayTenantsYAMLTmplFile, "gateway-tenants.yaml"))
+
+	lokiGatewayNginxTmpl = template.Must(template.ParseFS(lokiGatewayNginxTmplFile, "nginx.conf"))
+)
+
+// Build builds the lokistack-gateway configuration files. In passthrough mode only
+// the nginx configuration is rendered, otherwise only the rbac and tenants configuration.
+func Build(opts Options) (rbacCfg []byte, tenantsCfg []byte, nginxCfg []byte, err error) {
+	if opts.Mode == lokiv1.Passthrough {
+		nginxCfg, err = render(lokiGatewayNginxTmpl, opts)
+		if err != nil {
+			return nil, nil, nil, kverrors.Wrap(err, "failed to create loki gateway nginx configuration")
+		}
+		return nil, nil, nginxCfg, nil
+	}
+
+	rbacCfg, err = render(lokiGatewayRbacYAMLTmpl, opts)
+	if err != nil {
+		return nil, nil, nil, kverrors.Wrap(err, "failed to create loki gateway rbac configuration")
+	}
+
+	tenantsCfg, err = render(lokiGatewayTenantsYAMLTmpl, opts)
+	if err != nil {
+		return nil, nil, nil, kverrors.Wrap(err, "failed to create loki gateway tenants configuration")
+	}
+
+	return rbacCfg, tenantsCfg, nil, nil
+}
+
+func render(tmpl *template.Template, opts Options) ([]byte, error) {
+	w := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(w, opts); err != nil {
+		return nil, err
+	}
+
+	cfg, err := io.ReadAll(w)
+	if err != nil {
+		return nil, kverrors.Wrap(err, "failed to read configuration from buffer")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,33 @@
+package gateway
+
+import (
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+)
+
+// Options is used to render the lokistack-gateway configuration file templates
+type Options struct {
+	Mode           lokiv1.ModeType
+	Authentication []lokiv1.AuthenticationSpec
+	Roles          []lokiv1.RoleSpec
+	RoleBindings   []lokiv1.RoleBindingsSpec
+	TenantSecrets  []*Secret
+
+	// Distributor and QueryFrontend are the upstream endpoints used in passthrough mode.
+	Distributor   Address
+	QueryFrontend Address
+}
+
+// Address FQDN and port for a k8s service.
+type Address struct {
+	// FQDN is required
+	FQDN string
+	// Port is required
+	Port int
+}
+
+// Secret for clientID and clientSecret for tenant's authentication.
+type Secret struct {
+	TenantName   string
+	ClientID     string
+	ClientSecret string
+}
@@ -4,12 +4,17 @@ import (
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 type ComponentResources struct {
 	IndexGateway ResourceRequirements
 	Ingester     ResourceRequirements
 	Compactor    ResourceRequirements
+	Ruler        ResourceRequirements
+	WALStorage   ResourceRequirements
+	FileSystem   ResourceRequirements
+	MinIO        ResourceRequirements
 
 	Querier       corev1.ResourceRequirements
 	Distributor   corev1.ResourceRequirements
@@ -27,6 +32,9 @@ type ResourceRequirements struct {
 var StackSizeTable = map[lokiv1.LokiStackSizeType]lokiv1.LokiStackSpec{
 	lokiv1.SizeOneXDemo: {
 		Size: lokiv1.SizeOneXDemo,
+		Replication: &lokiv1.ReplicationSpec{
+			Factor: 1,
+		},
 		Limits: &lokiv1.LimitsSpec{
 			Global: &lokiv1.LimitsTemplateSpec{
 				IngestionLimits: &lokiv1.IngestionLimitSpec{
@@ -81,6 +89,16 @@ var StackSizeTable = map[lokiv1.LokiStackSizeType]lokiv1.LokiStackSpec{
 	},
 	lokiv1.SizeOneXExtraSmall: {
 		Size: lokiv1.SizeOneXExtraSmall,
+		Replication: &lokiv1.ReplicationSpec{
+			Factor: 2,
+		},
+		Ingester: &lokiv1.IngesterSpec{
+			WAL: &lokiv1.IngesterWALSpec{
+				Enabled:             true,
+				ReplayMemoryCeiling: ptr.To(resource.MustParse("4Gi")),
+				CheckpointDuration:  "5m",
+			},
+		},
 		Limits: &lokiv1.LimitsSpec{
 			Global: &lokiv1.LimitsTemplateSpec{
 				IngestionLimits: &lokiv1.IngestionLimitSpec{
@@ -136,6 +154,16 @@ var StackSizeTable = map[lokiv1.LokiStackSizeType]lokiv1.LokiStackSpec{
 
 	lokiv1.SizeOneXSmall: {
 		Size: lokiv1.SizeOneXSmall,
+		Replication: &lokiv1.ReplicationSpec{
+			Factor: 2,
+		},
+		Ingester: &lokiv1.IngesterSpec{
+			WAL: &lokiv1.IngesterWALSpec{
+				Enabled:             true,
+				ReplayMemoryCeiling: ptr.To(resource.MustParse("10Gi")),
+				CheckpointDuration:  "5m",
+			},
+		},
 		Limits: &lokiv1.LimitsSpec{
 			Global: &lokiv1.LimitsTemplateSpec{
 				IngestionLimits: &lokiv1.IngestionLimitSpec{
@@ -193,6 +221,16 @@ var StackSizeTable = map[lokiv1.LokiStackSizeType]lokiv1.LokiStackSpec{
 
 	lokiv1.SizeOneXMedium: {
 		Size: lokiv1.SizeOneXMedium,
+		Replication: &lokiv1.ReplicationSpec{
+			Factor: 2,
+		},
+		Ingester: &lokiv1.IngesterSpec{
+			WAL: &lokiv1.IngesterWALSpec{
+				Enabled:             true,
+				ReplayMemoryCeiling: ptr.To(resource.MustParse("15Gi")),
+				CheckpointDuration:  "5m",
+			},
+		},
 		Limits: &lokiv1.LimitsSpec{
 			Global: &lokiv1.LimitsTemplateSpec{
 				IngestionLimits: &lokiv1.IngestionLimitSpec{
@@ -260,6 +298,18 @@ var ResourceRequirementsTable = map[lokiv1.LokiStackSizeType]ComponentResources{
 		IndexGateway: ResourceRequirements{
 			PVCSize: resource.MustParse("10Gi"),
 		},
+		Ruler: ResourceRequirements{
+			PVCSize: resource.MustParse("10Gi"),
+		},
+		WALStorage: ResourceRequirements{
+			PVCSize: resource.MustParse("10Gi"),
+		},
+		FileSystem: ResourceRequirements{
+			PVCSize: resource.MustParse("50Gi"),
+		},
+		MinIO: ResourceRequirements{
+			PVCSize: resource.MustParse("50Gi"),
+		},
 	},
 	lokiv1.SizeOneXExtraSmall: {
 		Querier: corev1.ResourceRequirements{
@@ -308,6 +358,16 @@ var ResourceRequirementsTable = map[lokiv1.LokiStackSizeType]ComponentResources{
 				corev1.ResourceMemory: resource.MustParse("1Gi"),
 			},
 		},
+		Ruler: ResourceRequirements{
+			PVCSize: resource.MustParse("10Gi"),
+			Requests: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		WALStorage: ResourceRequirements{
+			PVCSize: resource.MustParse("150Gi"),
+		},
 	},
 	lokiv1.SizeOneXSmall: {
 		Querier: corev1.ResourceRequirements{
@@ -356,6 +416,16 @@ var ResourceRequirementsTable = map[lokiv1.LokiStackSizeType]ComponentResources{
 				corev1.ResourceMemory: resource.MustParse("2Gi"),
 			},
 		},
+		Ruler: ResourceRequirements{
+			PVCSize: resource.MustParse("10Gi"),
+			Requests: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		WALStorage: ResourceRequirements{
+			PVCSize: resource.MustParse("150Gi"),
+		},
 	},
 	lokiv1.SizeOneXMedium: {
 		Querier: corev1.ResourceRequirements{
@@ -404,5 +474,15 @@ var ResourceRequirementsTable = map[lokiv1.LokiStackSizeType]ComponentResources{
 				corev1.ResourceMemory: resource.MustParse("2Gi"),
 			},
 		},
+		Ruler: ResourceRequirements{
+			PVCSize: resource.MustParse("10Gi"),
+			Requests: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+		WALStorage: ResourceRequirements{
+			PVCSize: resource.MustParse("150Gi"),
+		},
 	},
 }
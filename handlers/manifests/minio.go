@@ -0,0 +1,213 @@
+package manifests
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+)
+
+const (
+	// DefaultMinIOImage declares the default image for the operator-managed MinIO storage.
+	DefaultMinIOImage = "docker.io/minio/minio:RELEASE.2024-10-02T17-50-41Z"
+
+	// LabelMinIOComponent is the label value for the operator-managed MinIO storage.
+	LabelMinIOComponent string = "minio"
+
+	minioPortName      = "minio"
+	minioVolumeName    = "storage"
+	minioDataDirectory = "/storage"
+)
+
+// BuildMinIO returns a list of k8s objects for the operator-managed MinIO storage.
+func BuildMinIO(opts Options) []client.Object {
+	return []client.Object{
+		NewMinIOSecret(opts),
+		NewMinIOPersistentVolumeClaim(opts),
+		NewMinIODeployment(opts),
+		NewMinIOService(opts),
+	}
+}
+
+// NewMinIOSecret creates the secret holding the generated credentials of the MinIO storage.
+func NewMinIOSecret(opts Options) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   storage.MinIOName(opts.Name),
+			Labels: ComponentLabels(LabelMinIOComponent, opts.Name),
+		},
+		Data: opts.ObjectStorage.MinIO.SecretData(),
+		Type: corev1.SecretTypeOpaque,
+	}
+}
+
+// NewMinIOPersistentVolumeClaim creates the persistent volume claim storing the MinIO data.
+func NewMinIOPersistentVolumeClaim(opts Options) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   storage.MinIOName(opts.Name),
+			Labels: ComponentLabels(LabelMinIOComponent, opts.Name),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: map[corev1.ResourceName]resource.Quantity{
+					corev1.ResourceStorage: opts.ResourceRequirements.MinIO.PVCSize,
+				},
+			},
+			StorageClassName: ptr.To(opts.Stack.StorageClassName),
+			VolumeMode:       &volumeFileSystemMode,
+		},
+	}
+}
+
+// NewMinIODeployment creates a single-node MinIO deployment. The bucket is created
+// as a directory in the data volume before the server starts.
+func NewMinIODeployment(opts Options) *appsv1.Deployment {
+	l := ComponentLabels(LabelMinIOComponent, opts.Name)
+	name := storage.MinIOName(opts.Name)
+	a := map[string]string{
+		AnnotationLokiObjectStoreHash: opts.ObjectStorage.SecretSHA1,
+	}
+
+	podSpec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: minioVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: name,
+					},
+				},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Image: DefaultMinIOImage,
+				Name:  "minio",
+				Command: []string{
+					"/bin/sh",
+					"-c",
+					fmt.Sprintf("mkdir -p %[1]s/%[2]s && minio server %[1]s", minioDataDirectory, opts.ObjectStorage.MinIO.Bucket),
+				},
+				Env: []corev1.EnvVar{
+					minioSecretEnv("MINIO_ROOT_USER", name, storage.KeyAWSAccessKeyID),
+					minioSecretEnv("MINIO_ROOT_PASSWORD", name, storage.KeyAWSAccessKeySecret),
+				},
+				Ports: []corev1.ContainerPort{
+					{
+						Name:          minioPortName,
+						ContainerPort: storage.MinIOPort,
+						Protocol:      protocolTCP,
+					},
+				},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/minio/health/ready",
+							Port:   intstr.FromInt(storage.MinIOPort),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+					PeriodSeconds:    10,
+					FailureThreshold: 3,
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      minioVolumeName,
+						MountPath: minioDataDirectory,
+					},
+				},
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: "File",
+				ImagePullPolicy:          "IfNotPresent",
+			},
+		},
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: l,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: l,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Labels:      l,
+					Annotations: a,
+				},
+				Spec: podSpec,
+			},
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+		},
+	}
+}
+
+// NewMinIOService creates a k8s service for the MinIO S3 endpoint.
+func NewMinIOService(opts Options) *corev1.Service {
+	l := ComponentLabels(LabelMinIOComponent, opts.Name)
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   storage.MinIOName(opts.Name),
+			Labels: l,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       minioPortName,
+					Port:       storage.MinIOPort,
+					Protocol:   protocolTCP,
+					TargetPort: intstr.IntOrString{IntVal: storage.MinIOPort},
+				},
+			},
+			Selector: l,
+		},
+	}
+}
+
+func minioSecretEnv(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+			},
+		},
+	}
+}
@@ -18,6 +18,7 @@ import (
 // It currently supports the following types and will return an error for other types:
 //
 //   - ConfigMap
+//   - PersistentVolumeClaim
 //   - Secret
 //   - Service
 //   - ServiceAccount
@@ -66,6 +67,11 @@ func MutateFuncFor(existing, desired client.Object, depAnnotations map[string]st
 			}
 			s.SetAnnotations(existingAnnotations)
 
+		case *corev1.PersistentVolumeClaim:
+			pvc := existing.(*corev1.PersistentVolumeClaim)
+			wantPvc := desired.(*corev1.PersistentVolumeClaim)
+			mutatePersistentVolumeClaim(pvc, wantPvc)
+
 		case *corev1.Service:
 			svc := existing.(*corev1.Service)
 			wantSvc := desired.(*corev1.Service)
@@ -139,6 +145,10 @@ func mutateConfigMap(existing, desired *corev1.ConfigMap) {
 	existing.Data = desired.Data
 }
 
+func mutatePersistentVolumeClaim(existing, desired *corev1.PersistentVolumeClaim) {
+	existing.Spec.Resources.Requests = desired.Spec.Resources.Requests
+}
+
 func mutateSecret(existing, desired *corev1.Secret) {
 	existing.Annotations = desired.Annotations
 	existing.Labels = desired.Labels
@@ -5,8 +5,11 @@ import (
 	"strings"
 
 	"github.com/imdario/mergo"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 )
@@ -93,6 +96,71 @@ func configureReplication(podTemplate *corev1.PodTemplateSpec, replication *loki
 	return nil
 }
 
+// IngesterZones returns the zone values to run one ingester StatefulSet for each,
+// if the replication uses the statefulset-per-zone zone mode.
+func IngesterZones(replication *lokiv1.ReplicationSpec) []string {
+	if replication == nil || replication.ZoneMode != lokiv1.ReplicationZoneModeStatefulSetPerZone || len(replication.Zones) != 1 {
+		return nil
+	}
+
+	return replication.Zones[0].Values
+}
+
+// configureIngesterZone turns the ingester StatefulSet into the one for the given zone. Its pods
+// are pinned to the zone by node affinity and use the zone as static availability zone.
+func configureIngesterZone(sts *appsv1.StatefulSet, stackName, topologyKey, zone string, replicas int32) {
+	zoneLabels := map[string]string{
+		lokiv1.LabelIngesterZone: zone,
+	}
+
+	sts.Name = IngesterZoneName(stackName, zone)
+	sts.Labels = labels.Merge(sts.Labels, zoneLabels)
+	sts.Spec.Replicas = ptr.To(replicas)
+	sts.Spec.Selector.MatchLabels = labels.Merge(sts.Spec.Selector.MatchLabels, zoneLabels)
+	sts.Spec.Template.Labels = labels.Merge(sts.Spec.Template.Labels, zoneLabels)
+	for i := range sts.Spec.VolumeClaimTemplates {
+		pvc := &sts.Spec.VolumeClaimTemplates[i]
+		pvc.Labels = labels.Merge(pvc.Labels, zoneLabels)
+	}
+
+	podSpec := &sts.Spec.Template.Spec
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{
+							Key:      topologyKey,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{zone},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, corev1.EnvVar{
+			Name:  availabilityZoneEnvVarName,
+			Value: zone,
+		})
+	}
+}
+
+// zoneReplicas returns the replicas of the i-th out of n zones, when distributing
+// the total replicas evenly across the zones.
+func zoneReplicas(total int32, n, i int) int32 {
+	replicas := total / int32(n)
+	if int32(i) < total%int32(n) {
+		replicas++
+	}
+	return replicas
+}
+
 func initContainerAZAnnotationCheck(image string) corev1.Container {
 	azPath := fmt.Sprintf("%s/%s", availabilityZoneInitVolumeMountPath, availabilityZoneInitVolumeFileName)
 	return corev1.Container{
@@ -0,0 +1,55 @@
+package manifests
+
+import "testing"
+
+func TestZoneReplicas(t *testing.T) {
+	tt := []struct {
+		desc  string
+		total int32
+		zones int
+		want  []int32
+	}{
+		{
+			desc:  "evenly distributed",
+			total: 6,
+			zones: 3,
+			want:  []int32{2, 2, 2},
+		},
+		{
+			desc:  "remainder to the first zones",
+			total: 5,
+			zones: 3,
+			want:  []int32{2, 2, 1},
+		},
+		{
+			desc:  "one replica per zone",
+			total: 3,
+			zones: 3,
+			want:  []int32{1, 1, 1},
+		},
+		{
+			desc:  "single zone",
+			total: 4,
+			zones: 1,
+			want:  []int32{4},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			var sum int32
+			for i, want := range tc.want {
+				got := zoneReplicas(tc.total, tc.zones, i)
+				if got != want {
+					t.Errorf("got %d replicas for zone %d, want %d", got, i, want)
+				}
+				sum += got
+			}
+
+			if sum != tc.total {
+				t.Errorf("got %d replicas in total, want %d", sum, tc.total)
+			}
+		})
+	}
+}
@@ -26,11 +26,40 @@ type Options struct {
 	Timeouts TimeoutConfig
 
 	ObjectStorage storage.Options
+
+	Tenants Tenants
+
+	AlertingRules  []lokiv1.AlertingRule
+	RecordingRules []lokiv1.RecordingRule
+	RulerConfig    *lokiv1.RulerConfigSpec
+
+	// RulerSecretSHA1 is the hash of the alertmanager secrets referenced by the RulerConfig.
+	RulerSecretSHA1 string
+}
+
+// Tenants contains the configuration per tenant and secrets for authn/authz.
+type Tenants struct {
+	Secrets []*TenantSecrets
+}
+
+// TenantSecrets for clientID and clientSecret for tenant's authentication.
+type TenantSecrets struct {
+	TenantName   string
+	ClientID     string
+	ClientSecret string
 }
 
 // TimeoutConfig contains the server configuration options for all Loki components
 type TimeoutConfig struct {
-	Loki config.HTTPTimeoutConfig
+	Loki    config.HTTPTimeoutConfig
+	Gateway GatewayTimeoutConfig
+}
+
+// GatewayTimeoutConfig contains the http server configuration options for all Loki components
+type GatewayTimeoutConfig struct {
+	ReadTimeout          time.Duration
+	WriteTimeout         time.Duration
+	UpstreamWriteTimeout time.Duration
 }
 
 func calculateHTTPTimeouts(queryTimeout time.Duration) TimeoutConfig {
@@ -48,5 +77,10 @@ func calculateHTTPTimeouts(queryTimeout time.Duration) TimeoutConfig {
 			ReadTimeout:  readTimeout,
 			WriteTimeout: writeTimeout,
 		},
+		Gateway: GatewayTimeoutConfig{
+			ReadTimeout:          readTimeout + gatewayReadDuration,
+			WriteTimeout:         writeTimeout + gatewayWriteDuration,
+			UpstreamWriteTimeout: writeTimeout,
+		},
 	}
 }
@@ -49,7 +49,7 @@ func BuildQuerier(opts Options) ([]client.Object, error) {
 // NewQuerierDeployment creates a deployment object for a querier
 func NewQuerierDeployment(opts Options) *appsv1.Deployment {
 	l := ComponentLabels(LabelQuerierComponent, opts.Name)
-	a := commonAnnotations(opts)
+	a := storageAnnotations(opts)
 	podSpec := corev1.PodSpec{
 		ServiceAccountName: opts.Name,
 		Volumes: []corev1.Volume{
@@ -64,6 +64,17 @@ func NewQuerierDeployment(opts Options) *appsv1.Deployment {
 					},
 				},
 			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
 		},
 		Containers: []corev1.Container{
 			{
@@ -76,7 +87,7 @@ func NewQuerierDeployment(opts Options) *appsv1.Deployment {
 				Args: []string{
 					"-target=querier",
 					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
-					// fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiRuntimeConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
 					"-config.expand-env=true",
 				},
 				ReadinessProbe: lokiReadinessProbe(),
@@ -104,6 +115,11 @@ func NewQuerierDeployment(opts Options) *appsv1.Deployment {
 						ReadOnly:  false,
 						MountPath: config.LokiConfigMountDir,
 					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
 				},
 				TerminationMessagePath:   "/dev/termination-log",
 				TerminationMessagePolicy: "File",
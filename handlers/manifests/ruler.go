@@ -0,0 +1,457 @@
+package manifests
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/config"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BuildRuler returns a list of k8s objects for Loki Stack Ruler
+func BuildRuler(opts Options) ([]client.Object, error) {
+	shards, rulesProjections, err := RulesConfigMapShards(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulSet := NewRulerStatefulSet(opts, rulesProjections)
+
+	if err := storage.ConfigureStatefulSet(statefulSet, opts.ObjectStorage); err != nil {
+		return nil, err
+	}
+
+	configureRemoteWriteEnv(&statefulSet.Spec.Template.Spec, opts)
+	configureAlertManagerSecrets(&statefulSet.Spec.Template.Spec, opts)
+
+	if err := configureHashRingEnv(&statefulSet.Spec.Template.Spec, opts); err != nil {
+		return nil, err
+	}
+
+	if err := configureProxyEnv(&statefulSet.Spec.Template.Spec, opts); err != nil {
+		return nil, err
+	}
+
+	if err := configureReplication(&statefulSet.Spec.Template, opts.Stack.Replication, LabelRulerComponent, opts.Name); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, 0, len(shards)+4)
+	for _, cm := range shards {
+		objs = append(objs, cm)
+	}
+
+	return append(objs,
+		statefulSet,
+		NewRulerGRPCService(opts),
+		NewRulerHTTPService(opts),
+		NewRulerPodDisruptionBudget(opts),
+	), nil
+}
+
+// NewRulerStatefulSet creates a statefulset object for a ruler. The rule files
+// are projected from the given config map shards into the rules directory.
+func NewRulerStatefulSet(opts Options, rulesProjections []corev1.VolumeProjection) *appsv1.StatefulSet {
+	l := ComponentLabels(LabelRulerComponent, opts.Name)
+	a := storageAnnotations(opts)
+	if opts.RulerSecretSHA1 != "" {
+		a[AnnotationLokiRulerSecretHash] = opts.RulerSecretSHA1
+	}
+
+	podSpec := corev1.PodSpec{
+		ServiceAccountName: opts.Name,
+		Volumes: []corev1.Volume{
+			{
+				Name: configVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
+			{
+				Name: runtimeConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						DefaultMode: &defaultConfigMapMode,
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: lokiRuntimeConfigMapName(opts.Name),
+						},
+					},
+				},
+			},
+			{
+				Name:         rulesVolumeName,
+				VolumeSource: rulesVolumeSource(rulesProjections),
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Image: opts.Image,
+				Name:  "loki-ruler",
+				Resources: corev1.ResourceRequirements{
+					Limits:   opts.ResourceRequirements.Ruler.Limits,
+					Requests: opts.ResourceRequirements.Ruler.Requests,
+				},
+				Args: []string{
+					"-target=ruler",
+					fmt.Sprintf("-config.file=%s", path.Join(config.LokiConfigMountDir, config.LokiConfigFileName)),
+					fmt.Sprintf("-runtime-config.file=%s", path.Join(config.LokiRuntimeConfigMountDir, config.LokiRuntimeConfigFileName)),
+					"-config.expand-env=true",
+				},
+				ReadinessProbe: lokiReadinessProbe(),
+				LivenessProbe:  lokiLivenessProbe(),
+				Ports: []corev1.ContainerPort{
+					{
+						Name:          lokiHTTPPortName,
+						ContainerPort: httpPort,
+						Protocol:      protocolTCP,
+					},
+					{
+						Name:          lokiGRPCPortName,
+						ContainerPort: grpcPort,
+						Protocol:      protocolTCP,
+					},
+					{
+						Name:          lokiGossipPortName,
+						ContainerPort: gossipPort,
+						Protocol:      protocolTCP,
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      configVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiConfigMountDir,
+					},
+					{
+						Name:      runtimeConfigVolumeName,
+						ReadOnly:  false,
+						MountPath: config.LokiRuntimeConfigMountDir,
+					},
+					{
+						Name:      rulesVolumeName,
+						ReadOnly:  false,
+						MountPath: rulesStorageDirectory,
+					},
+					{
+						Name:      walVolumeName,
+						ReadOnly:  false,
+						MountPath: walDirectory,
+					},
+					{
+						Name:      storageVolumeName,
+						ReadOnly:  false,
+						MountPath: dataDirectory,
+					},
+				},
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: "File",
+				ImagePullPolicy:          "IfNotPresent",
+			},
+		},
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsUser: ptr.To(int64(1000)),
+			FSGroup:   ptr.To(int64(1000)),
+		},
+	}
+
+	if opts.Stack.Template != nil && opts.Stack.Template.Ruler != nil {
+		podSpec.Tolerations = opts.Stack.Template.Ruler.Tolerations
+		podSpec.NodeSelector = opts.Stack.Template.Ruler.NodeSelector
+	}
+
+	return &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   RulerName(opts.Name),
+			Labels: l,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			PodManagementPolicy:  appsv1.OrderedReadyPodManagement,
+			RevisionHistoryLimit: ptr.To(defaultRevHistoryLimit),
+			Replicas:             ptr.To(opts.Stack.Template.Ruler.Replicas),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels.Merge(l, GossipLabels()),
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        fmt.Sprintf("loki-ruler-%s", opts.Name),
+					Labels:      labels.Merge(l, GossipLabels()),
+					Annotations: a,
+				},
+				Spec: podSpec,
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: l,
+						Name:   storageVolumeName,
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{
+							corev1.ReadWriteOnce,
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: map[corev1.ResourceName]resource.Quantity{
+								corev1.ResourceStorage: opts.ResourceRequirements.Ruler.PVCSize,
+							},
+						},
+						StorageClassName: ptr.To(opts.Stack.StorageClassName),
+						VolumeMode:       &volumeFileSystemMode,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: l,
+						Name:   walVolumeName,
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{
+							corev1.ReadWriteOnce,
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: map[corev1.ResourceName]resource.Quantity{
+								corev1.ResourceStorage: opts.ResourceRequirements.WALStorage.PVCSize,
+							},
+						},
+						StorageClassName: ptr.To(opts.Stack.StorageClassName),
+						VolumeMode:       &volumeFileSystemMode,
+					},
+				},
+			},
+		},
+	}
+}
+
+// RemoteWriteSecretKeys returns the secret keys required by the given remote write authorization type.
+func RemoteWriteSecretKeys(t lokiv1.RemoteWriteAuthType) []string {
+	switch t {
+	case lokiv1.BasicAuthorization:
+		return []string{rulerSecretUsernameKey, rulerSecretPasswordKey}
+	case lokiv1.BearerAuthorization:
+		return []string{rulerSecretBearerTokenKey}
+	default:
+		return nil
+	}
+}
+
+// configureRemoteWriteEnv exposes the remote write credentials of the
+// authorization secret as environment variables to the ruler container.
+func configureRemoteWriteEnv(p *corev1.PodSpec, opts Options) {
+	if !RemoteWriteEnabled(opts.Stack) {
+		return
+	}
+
+	c := opts.Stack.Rules.RemoteWrite.Client
+	envNames := map[string]string{
+		rulerSecretUsernameKey:    envRulerRemoteWriteUsername,
+		rulerSecretPasswordKey:    envRulerRemoteWritePassword,
+		rulerSecretBearerTokenKey: envRulerRemoteWriteBearerToken,
+	}
+
+	for _, key := range RemoteWriteSecretKeys(c.AuthorizationType) {
+		p.Containers[0].Env = append(p.Containers[0].Env, corev1.EnvVar{
+			Name: envNames[key],
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: c.AuthorizationSecretName,
+					},
+					Key: key,
+				},
+			},
+		})
+	}
+}
+
+// configureAlertManagerSecrets exposes the alertmanager client secrets to the ruler container,
+// as environment variables for the ruler config and as files for the tenant overrides.
+func configureAlertManagerSecrets(p *corev1.PodSpec, opts Options) {
+	rc := opts.RulerConfig
+	if !rulerEnabled(opts.Stack) || rc == nil {
+		return
+	}
+
+	if am := rc.AlertManagerSpec; am != nil && am.Client != nil {
+		if ba := am.Client.BasicAuth; ba != nil && ba.Password != nil {
+			p.Containers[0].Env = append(p.Containers[0].Env, secretKeyEnvVar(envRulerAlertManagerPassword, ba.Password))
+		}
+		if ha := am.Client.HeaderAuth; ha != nil && ha.Credentials != nil {
+			p.Containers[0].Env = append(p.Containers[0].Env, secretKeyEnvVar(envRulerAlertManagerCredentials, ha.Credentials))
+		}
+	}
+
+	tenants := make([]string, 0, len(rc.Overrides))
+	for tenant := range rc.Overrides {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+
+	var sources []corev1.VolumeProjection
+	for _, tenant := range tenants {
+		am := rc.Overrides[tenant].AlertManagerOverrides
+		if am == nil || am.Client == nil || am.Client.HeaderAuth == nil || am.Client.HeaderAuth.Credentials == nil {
+			continue
+		}
+
+		ref := am.Client.HeaderAuth.Credentials
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: ref.LocalObjectReference,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  ref.Key,
+						Path: path.Join(tenant, alertManagerCredentialsFileName),
+					},
+				},
+			},
+		})
+	}
+
+	if len(sources) == 0 {
+		return
+	}
+
+	p.Volumes = append(p.Volumes, corev1.Volume{
+		Name: alertManagerCredentialsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	})
+	p.Containers[0].VolumeMounts = append(p.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      alertManagerCredentialsVolumeName,
+		ReadOnly:  true,
+		MountPath: alertManagerCredentialsDirectory,
+	})
+}
+
+// alertManagerCredentialsPath returns the path of the alertmanager header credentials of a tenant.
+func alertManagerCredentialsPath(tenant string) string {
+	return path.Join(alertManagerCredentialsDirectory, tenant, alertManagerCredentialsFileName)
+}
+
+func secretKeyEnvVar(name string, ref *corev1.SecretKeySelector) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: ref.DeepCopy(),
+		},
+	}
+}
+
+func rulesVolumeSource(projections []corev1.VolumeProjection) corev1.VolumeSource {
+	if len(projections) == 0 {
+		return corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}
+	}
+
+	return corev1.VolumeSource{
+		Projected: &corev1.ProjectedVolumeSource{
+			DefaultMode: &defaultConfigMapMode,
+			Sources:     projections,
+		},
+	}
+}
+
+// NewRulerGRPCService creates a k8s service for the ruler GRPC endpoint
+func NewRulerGRPCService(opts Options) *corev1.Service {
+	serviceName := serviceNameRulerGRPC(opts.Name)
+	labels := ComponentLabels(LabelRulerComponent, opts.Name)
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceName,
+			Labels: labels,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []corev1.ServicePort{
+				{
+					Name:       lokiGRPCPortName,
+					Port:       grpcPort,
+					Protocol:   protocolTCP,
+					TargetPort: intstr.IntOrString{IntVal: grpcPort},
+				},
+			},
+			Selector: labels,
+		},
+	}
+}
+
+// NewRulerHTTPService creates a k8s service for the ruler HTTP endpoint
+func NewRulerHTTPService(opts Options) *corev1.Service {
+	serviceName := serviceNameRulerHTTP(opts.Name)
+	labels := ComponentLabels(LabelRulerComponent, opts.Name)
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceName,
+			Labels: labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       lokiHTTPPortName,
+					Port:       httpPort,
+					Protocol:   protocolTCP,
+					TargetPort: intstr.IntOrString{IntVal: httpPort},
+				},
+			},
+			Selector: labels,
+		},
+	}
+}
+
+// NewRulerPodDisruptionBudget returns a PodDisruptionBudget for the LokiStack
+// ruler pods. It allows a single unavailable pod, so that a single ruler
+// replica does not block node drains.
+func NewRulerPodDisruptionBudget(opts Options) *policyv1.PodDisruptionBudget {
+	l := ComponentLabels(LabelRulerComponent, opts.Name)
+	mu := intstr.FromInt(1)
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: policyv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:    l,
+			Name:      RulerName(opts.Name),
+			Namespace: opts.Namespace,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: l,
+			},
+			MaxUnavailable: &mu,
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package manifests
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/internal/rules"
+)
+
+// maxRulesConfigMapSize is the maximum amount of rule file content stored in a
+// single config map shard. It keeps each shard below the 1MiB object size limit.
+const maxRulesConfigMapSize = 1000000
+
+type ruleFile struct {
+	tenantID string
+	key      string
+	content  string
+}
+
+// RulesConfigMapShards returns the config maps containing the per-tenant rule files
+// and the volume projections to mount them into the ruler rules directory.
+func RulesConfigMapShards(opts Options) ([]*corev1.ConfigMap, []corev1.VolumeProjection, error) {
+	files, err := ruleFiles(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		shards []*corev1.ConfigMap
+		sizes  []int
+		items  [][]corev1.KeyToPath
+	)
+	for _, f := range files {
+		last := len(shards) - 1
+		if last < 0 || sizes[last]+len(f.content) > maxRulesConfigMapSize {
+			shards = append(shards, newRulesConfigMap(opts, len(shards)))
+			sizes = append(sizes, 0)
+			items = append(items, nil)
+			last++
+		}
+
+		shards[last].Data[f.key] = f.content
+		sizes[last] += len(f.content)
+		items[last] = append(items[last], corev1.KeyToPath{
+			Key:  f.key,
+			Path: fmt.Sprintf("%s/%s", f.tenantID, f.key),
+		})
+	}
+
+	projections := make([]corev1.VolumeProjection, 0, len(shards))
+	for i, cm := range shards {
+		projections = append(projections, corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: cm.Name,
+				},
+				Items: items[i],
+			},
+		})
+	}
+
+	return shards, projections, nil
+}
+
+func ruleFiles(opts Options) ([]ruleFile, error) {
+	var files []ruleFile
+	for _, r := range opts.AlertingRules {
+		c, err := rules.MarshalAlertingRule(r)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, ruleFile{
+			tenantID: r.Spec.TenantID,
+			key:      fmt.Sprintf("%s-%s-%s.yaml", r.Namespace, r.Name, r.UID),
+			content:  c,
+		})
+	}
+
+	for _, r := range opts.RecordingRules {
+		c, err := rules.MarshalRecordingRule(r)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, ruleFile{
+			tenantID: r.Spec.TenantID,
+			key:      fmt.Sprintf("%s-%s-%s.yaml", r.Namespace, r.Name, r.UID),
+			content:  c,
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+
+	return files, nil
+}
+
+func newRulesConfigMap(opts Options, shard int) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   RulesConfigMapName(opts.Name, shard),
+			Labels: ComponentLabels(LabelRulerComponent, opts.Name),
+		},
+		Data: map[string]string{},
+	}
+}
@@ -1,26 +1,35 @@
 package storage
 
 import (
+	"path"
+
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/ViaQ/logerr/v2/kverrors"
 	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // ConfigureDeployment appends additional pod volumes and container env vars, args, volume mounts
 // based on the object storage type. Currently supported amendments:
-// - All: Ensure object storage secret mounted and auth projected as env vars.
+// - All: Ensure object storage secret mounted and auth projected as env vars from the secret keys in credentialsEnvKeys.
 // - GCS: Ensure env var GOOGLE_APPLICATION_CREDENTIALS in container
-// - S3: Ensure mounting custom CA configmap if any TLSConfig given
+// - FileSystem: Ensure the shared storage volume mounted and the pod co-located with all other storage pods
+// - S3: Ensure projected service account token and env vars AWS_ROLE_ARN, AWS_WEB_IDENTITY_TOKEN_FILE in container if token credentials given
+// - S3: Ensure env var AWS_SSE_KMS_ENCRYPTION_CONTEXT in container if a KMS encryption context given
+// - All: Ensure mounting custom CA configmap if any TLSConfig given
 func ConfigureDeployment(d *appsv1.Deployment, opts Options) error {
 	switch opts.SharedStore {
-	case lokiv1.ObjectStorageSecretS3:
+	case lokiv1.ObjectStorageSecretAlibabaCloud, lokiv1.ObjectStorageSecretAzure, lokiv1.ObjectStorageSecretGCS, lokiv1.ObjectStorageSecretS3, lokiv1.ObjectStorageSecretSwift:
 		err := configureDeployment(d, opts)
 		if err != nil {
 			return err
 		}
 		return nil
+	case lokiv1.ObjectStorageSecretFileSystem:
+		return configureFileSystem(&d.Spec.Template, opts)
 	default:
 		return nil
 	}
@@ -28,11 +37,13 @@ func ConfigureDeployment(d *appsv1.Deployment, opts Options) error {
 
 func ConfigureStatefulSet(d *appsv1.StatefulSet, opts Options) error {
 	switch opts.SharedStore {
-	case lokiv1.ObjectStorageSecretS3:
+	case lokiv1.ObjectStorageSecretAlibabaCloud, lokiv1.ObjectStorageSecretAzure, lokiv1.ObjectStorageSecretGCS, lokiv1.ObjectStorageSecretS3, lokiv1.ObjectStorageSecretSwift:
 		if err := configureStatefulSet(d, opts); err != nil {
 			return err
 		}
 		return nil
+	case lokiv1.ObjectStorageSecretFileSystem:
+		return configureFileSystem(&d.Spec.Template, opts)
 	default:
 		return nil
 	}
@@ -79,11 +90,205 @@ func ensureObjectStoreCredentials(p *corev1.PodSpec, opts Options) corev1.PodSpe
 		ReadOnly:  false,
 		MountPath: secretDirectory,
 	})
+	container.Env = append(container.Env, credentialsEnv(opts)...)
+
+	if opts.CredentialMode == lokiv1.CredentialModeToken {
+		volumes = append(volumes, saTokenVolume(opts))
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      saTokenVolumeName,
+			ReadOnly:  false,
+			MountPath: saTokenVolumeMountPath,
+		})
+	}
+
+	if tls := opts.TLS; tls != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: storageTLSVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: tls.CA,
+					},
+				},
+			},
+		})
+
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      storageTLSVolume,
+			ReadOnly:  false,
+			MountPath: caDirectory,
+		})
+	}
+
+	return corev1.PodSpec{
+		Containers: []corev1.Container{
+			*container,
+		},
+		Volumes: volumes,
+	}
+}
+
+// saTokenVolume returns a projected volume of a short-lived service account token
+// exchanged for object storage credentials.
+func saTokenVolume(opts Options) corev1.Volume {
+	audience := awsDefaultAudience
+	if opts.S3 != nil && opts.S3.Audience != "" {
+		audience = opts.S3.Audience
+	}
+
+	return corev1.Volume{
+		Name: saTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: ptr.To(saTokenExpiration),
+							Path:              corev1.ServiceAccountTokenKey,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// configureFileSystem merges the filesystem storage volume into the pod template.
+// All pods mounting the volume are labeled and required to run on the same node,
+// because the volume is only mountable read-write by a single node.
+func configureFileSystem(t *corev1.PodTemplateSpec, opts Options) error {
+	if t.Labels == nil {
+		t.Labels = map[string]string{}
+	}
+	t.Labels[fileSystemPodLabel] = opts.FileSystem.StackName
+
+	p := ensureFileSystemVolume(&t.Spec, opts)
+	if err := mergo.Merge(&t.Spec, p, mergo.WithOverride); err != nil {
+		return kverrors.Wrap(err, "failed to merge filesystem storage spec")
+	}
+
+	return nil
+}
+
+func ensureFileSystemVolume(p *corev1.PodSpec, opts Options) corev1.PodSpec {
+	container := p.Containers[0].DeepCopy()
+	volumes := p.Volumes
+
+	volumes = append(volumes, corev1.Volume{
+		Name: fileSystemVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: FileSystemClaimName(opts.FileSystem.StackName),
+			},
+		},
+	})
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      fileSystemVolumeName,
+		ReadOnly:  false,
+		MountPath: opts.FileSystem.Directory,
+	})
 
 	return corev1.PodSpec{
 		Containers: []corev1.Container{
 			*container,
 		},
 		Volumes: volumes,
+		Affinity: &corev1.Affinity{
+			PodAffinity: &corev1.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								fileSystemPodLabel: opts.FileSystem.StackName,
+							},
+						},
+						TopologyKey: corev1.LabelHostname,
+					},
+				},
+			},
+		},
+	}
+}
+
+// secretEnvKey maps a secret data key to the container env var exposing it.
+type secretEnvKey struct {
+	env string
+	key string
+}
+
+// credentialsEnvKeys lists per object storage type the secret data keys
+// referenced by the Loki config as env vars when using static credentials.
+var credentialsEnvKeys = map[lokiv1.ObjectStorageSecretType][]secretEnvKey{
+	lokiv1.ObjectStorageSecretAlibabaCloud: {
+		{env: EnvAlibabaCloudAccessKeyID, key: KeyAlibabaCloudAccessKeyID},
+		{env: EnvAlibabaCloudAccessKeySecret, key: KeyAlibabaCloudSecretAccessKey},
+	},
+	lokiv1.ObjectStorageSecretAzure: {
+		{env: EnvAzureStorageAccountName, key: KeyAzureStorageAccountName},
+		{env: EnvAzureStorageAccountKey, key: KeyAzureStorageAccountKey},
+	},
+	lokiv1.ObjectStorageSecretS3: {
+		{env: EnvAWSAccessKeyID, key: KeyAWSAccessKeyID},
+		{env: EnvAWSAccessKeySecret, key: KeyAWSAccessKeySecret},
+	},
+	lokiv1.ObjectStorageSecretSwift: {
+		{env: EnvSwiftUsername, key: KeySwiftUsername},
+		{env: EnvSwiftPassword, key: KeySwiftPassword},
+	},
+}
+
+// tokenCredentialsEnvKeys lists per object storage type the secret data keys
+// projected as env vars when using short-lived token credentials.
+var tokenCredentialsEnvKeys = map[lokiv1.ObjectStorageSecretType][]secretEnvKey{
+	lokiv1.ObjectStorageSecretS3: {
+		{env: EnvAWSRoleArn, key: KeyAWSRoleArn},
+	},
+}
+
+func credentialsEnv(opts Options) []corev1.EnvVar {
+	keys := credentialsEnvKeys[opts.SharedStore]
+	if opts.CredentialMode == lokiv1.CredentialModeToken {
+		keys = tokenCredentialsEnvKeys[opts.SharedStore]
+	}
+
+	var envs []corev1.EnvVar
+	for _, k := range keys {
+		envs = append(envs, secretKeyEnv(k.env, opts.SecretName, k.key))
+	}
+
+	switch opts.SharedStore {
+	case lokiv1.ObjectStorageSecretGCS:
+		envs = append(envs, corev1.EnvVar{
+			Name:  EnvGoogleApplicationCredentials,
+			Value: path.Join(secretDirectory, KeyGCPServiceAccountKeyFilename),
+		})
+	case lokiv1.ObjectStorageSecretS3:
+		if opts.CredentialMode == lokiv1.CredentialModeToken {
+			envs = append(envs, corev1.EnvVar{
+				Name:  EnvAWSWebIdentityTokenFile,
+				Value: ServiceAccountTokenFilePath,
+			})
+		}
+		if opts.S3 != nil && opts.S3.SSE.Type == SSEKMSType && opts.S3.SSE.KMSEncryptionContext != "" {
+			envs = append(envs, secretKeyEnv(EnvAWSSseKmsEncryptionContext, opts.SecretName, KeyAWSSseKmsEncryptionContext))
+		}
+	}
+
+	return envs
+}
+
+func secretKeyEnv(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+			},
+		},
 	}
 }
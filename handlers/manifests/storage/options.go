@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"path"
+
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 )
 
@@ -12,9 +14,34 @@ type Options struct {
 	CredentialMode          lokiv1.CredentialMode
 	AllowStructuredMetadata bool
 
-	S3 *S3StorageConfig
+	Azure        *AzureStorageConfig
+	GCS          *GCSStorageConfig
+	S3           *S3StorageConfig
+	Swift        *SwiftStorageConfig
+	AlibabaCloud *AlibabaCloudStorageConfig
+	FileSystem   *FileSystemStorageConfig
+	MinIO        *MinIOStorageConfig
+
+	TLS *TLSConfig
 
 	SecretName string
+	SecretSHA1 string
+
+	// PreflightHash identifies the object storage configuration verified by the
+	// connectivity check. It is recorded in the LokiStack storage status.
+	PreflightHash string
+}
+
+// AzureStorageConfig for Azure storage config
+type AzureStorageConfig struct {
+	Env            string
+	Container      string
+	EndpointSuffix string
+}
+
+// GCSStorageConfig for GCS storage config
+type GCSStorageConfig struct {
+	Bucket string
 }
 
 // S3StorageConfig for S3 storage config
@@ -40,3 +67,66 @@ type S3SSEConfig struct {
 	KMSKeyID             string
 	KMSEncryptionContext string
 }
+
+// SwiftStorageConfig for Swift storage config
+type SwiftStorageConfig struct {
+	AuthURL           string
+	UserDomainName    string
+	UserDomainID      string
+	UserID            string
+	DomainID          string
+	DomainName        string
+	ProjectID         string
+	ProjectName       string
+	ProjectDomainID   string
+	ProjectDomainName string
+	Region            string
+	Container         string
+}
+
+// AlibabaCloudStorageConfig for AlibabaCloud storage config
+type AlibabaCloudStorageConfig struct {
+	Endpoint string
+	Bucket   string
+}
+
+// FileSystemStorageConfig for filesystem storage config
+type FileSystemStorageConfig struct {
+	StackName string
+	Directory string
+}
+
+// MinIOStorageConfig for the operator-managed MinIO storage config
+type MinIOStorageConfig struct {
+	StackName       string
+	Endpoint        string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// SecretData returns the S3 secret data to access the operator-managed MinIO storage.
+func (c MinIOStorageConfig) SecretData() map[string][]byte {
+	return map[string][]byte{
+		KeyAWSEndpoint:        []byte(c.Endpoint),
+		KeyAWSBucketNames:     []byte(c.Bucket),
+		KeyAWSAccessKeyID:     []byte(c.AccessKeyID),
+		KeyAWSAccessKeySecret: []byte(c.SecretAccessKey),
+		KeyAWSForcePathStyle:  []byte("true"),
+	}
+}
+
+// TLSConfig for object storage endpoints. Currently supported only by:
+// - S3
+type TLSConfig struct {
+	CA  string
+	Key string
+
+	// CASHA1 is the hash of the CA certificate. Loki reads the CA file only on startup.
+	CASHA1 string
+}
+
+// CAFile returns the path of the CA certificate mounted into the containers.
+func (c TLSConfig) CAFile() string {
+	return path.Join(caDirectory, c.Key)
+}
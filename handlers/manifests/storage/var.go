@@ -1,6 +1,128 @@
 package storage
 
+import "fmt"
+
 const (
+	// EnvAzureStorageAccountName is the environment variable to specify the Azure storage account name to access the container.
+	EnvAzureStorageAccountName = "LOKI_AZURE_STORAGE_ACCOUNT_NAME"
+	// EnvAzureStorageAccountKey is the environment variable to specify the Azure storage account key to access the container.
+	EnvAzureStorageAccountKey = "LOKI_AZURE_STORAGE_ACCOUNT_KEY"
+
+	// KeyAzureStorageAccountKey is the secret data key for the Azure storage account key.
+	KeyAzureStorageAccountKey = "account_key"
+	// KeyAzureStorageAccountName is the secret data key for the Azure storage account name.
+	KeyAzureStorageAccountName = "account_name"
+	// KeyAzureStorageContainerName is the secret data key for the Azure storage container name.
+	KeyAzureStorageContainerName = "container"
+	// KeyAzureStorageEndpointSuffix is the secret data key for the Azure storage endpoint URL suffix.
+	KeyAzureStorageEndpointSuffix = "endpoint_suffix"
+	// KeyAzureEnvironmentName is the secret data key for the Azure cloud environment name.
+	KeyAzureEnvironmentName = "environment"
+
+	// EnvGoogleApplicationCredentials is the environment variable to specify path to key.json
+	EnvGoogleApplicationCredentials = "GOOGLE_APPLICATION_CREDENTIALS"
+
+	// KeyGCPStorageBucketName is the secret data key for the GCS bucket name.
+	KeyGCPStorageBucketName = "bucketname"
+	// KeyGCPServiceAccountKeyFilename is the service account key filename containing the Google authentication credentials.
+	KeyGCPServiceAccountKeyFilename = "key.json"
+
+	// EnvAWSAccessKeyID is the environment variable to specify the AWS client id to access S3.
+	EnvAWSAccessKeyID = "AWS_ACCESS_KEY_ID"
+	// EnvAWSAccessKeySecret is the environment variable to specify the AWS client secret to access S3.
+	EnvAWSAccessKeySecret = "AWS_ACCESS_KEY_SECRET"
+	// EnvAWSRoleArn is the environment variable to specify the AWS role ARN secret for the federated identity workflow.
+	EnvAWSRoleArn = "AWS_ROLE_ARN"
+	// EnvAWSWebIdentityTokenFile is the environment variable to specify the path to the web identity token file used in the federated identity workflow.
+	EnvAWSWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	// EnvAWSSseKmsEncryptionContext is the environment variable to specify the AWS KMS encryption context when using type SSE-KMS.
+	EnvAWSSseKmsEncryptionContext = "AWS_SSE_KMS_ENCRYPTION_CONTEXT"
+
+	// KeyAWSBucketNames is the secret data key for the AWS S3 bucket names.
+	KeyAWSBucketNames = "bucketnames"
+	// KeyAWSEndpoint is the secret data key for the AWS endpoint URL.
+	KeyAWSEndpoint = "endpoint"
+	// KeyAWSAccessKeyID is the secret data key for the AWS client id to access S3.
+	KeyAWSAccessKeyID = "access_key_id"
+	// KeyAWSAccessKeySecret is the secret data key for the AWS client secret to access S3.
+	KeyAWSAccessKeySecret = "access_key_secret"
+	// KeyAWSRoleArn is the secret data key for the AWS role ARN used to retrieve short-lived tokens.
+	KeyAWSRoleArn = "role_arn"
+	// KeyAWSAudience is the secret data key for the audience of the projected service account token.
+	KeyAWSAudience = "audience"
+	// KeyAWSRegion is the secret data key for the AWS region.
+	KeyAWSRegion = "region"
+	// KeyAWSForcePathStyle is the secret data key for forcing path-style addressing of the S3 buckets.
+	KeyAWSForcePathStyle = "forcepathstyle"
+	// KeyAWSSSEType is the secret data key for the AWS server-side encryption type.
+	KeyAWSSSEType = "sse_type"
+	// KeyAWSSseKmsKeyID is the secret data key for the AWS SSE KMS key id.
+	KeyAWSSseKmsKeyID = "sse_kms_key_id"
+	// KeyAWSSseKmsEncryptionContext is the secret data key for the AWS SSE KMS encryption context.
+	KeyAWSSseKmsEncryptionContext = "sse_kms_encryption_context"
+
+	// EnvSwiftUsername is the environment variable to specify the Swift username.
+	EnvSwiftUsername = "LOKI_SWIFT_USERNAME"
+	// EnvSwiftPassword is the environment variable to specify the Swift password.
+	EnvSwiftPassword = "LOKI_SWIFT_PASSWORD"
+
+	// KeySwiftAuthURL is the secret data key for the OpenStack Swift authentication URL.
+	KeySwiftAuthURL = "auth_url"
+	// KeySwiftContainerName is the secret data key for the OpenStack Swift container name.
+	KeySwiftContainerName = "container_name"
+	// KeySwiftDomainID is the secret data key for the OpenStack domain ID.
+	KeySwiftDomainID = "domain_id"
+	// KeySwiftDomainName is the secret data key for the OpenStack domain name.
+	KeySwiftDomainName = "domain_name"
+	// KeySwiftPassword is the secret data key for the OpenStack Swift password.
+	KeySwiftPassword = "password"
+	// KeySwiftProjectDomainID is the secret data key for the OpenStack project's domain id.
+	KeySwiftProjectDomainID = "project_domain_id"
+	// KeySwiftProjectDomainName is the secret data key for the OpenStack project's domain name.
+	KeySwiftProjectDomainName = "project_domain_name"
+	// KeySwiftProjectID is the secret data key for the OpenStack project id.
+	KeySwiftProjectID = "project_id"
+	// KeySwiftProjectName is the secret data key for the OpenStack project name.
+	KeySwiftProjectName = "project_name"
+	// KeySwiftRegion is the secret data key for the OpenStack Swift region.
+	KeySwiftRegion = "region"
+	// KeySwiftUserDomainID is the secret data key for the OpenStack Swift user domain id.
+	KeySwiftUserDomainID = "user_domain_id"
+	// KeySwiftUserDomainName is the secret data key for the OpenStack Swift user domain name.
+	KeySwiftUserDomainName = "user_domain_name"
+	// KeySwiftUserID is the secret data key for the OpenStack Swift user id.
+	KeySwiftUserID = "user_id"
+	// KeySwiftUsername is the secret data key for the OpenStack Swift password.
+	KeySwiftUsername = "username"
+
+	// EnvAlibabaCloudAccessKeyID is the environment variable to specify the AlibabaCloud client id to access OSS.
+	EnvAlibabaCloudAccessKeyID = "LOKI_ALIBABA_CLOUD_ACCESS_KEY_ID"
+	// EnvAlibabaCloudAccessKeySecret is the environment variable to specify the AlibabaCloud client secret to access OSS.
+	EnvAlibabaCloudAccessKeySecret = "LOKI_ALIBABA_CLOUD_ACCESS_KEY_SECRET"
+
+	// KeyAlibabaCloudAccessKeyID is the secret data key for the AlibabaCloud client id to access OSS.
+	KeyAlibabaCloudAccessKeyID = "access_key_id"
+	// KeyAlibabaCloudSecretAccessKey is the secret data key for the AlibabaCloud client secret to access OSS.
+	KeyAlibabaCloudSecretAccessKey = "secret_access_key"
+	// KeyAlibabaCloudBucket is the secret data key for the AlibabaCloud OSS bucket name.
+	KeyAlibabaCloudBucket = "bucket"
+	// KeyAlibabaCloudEndpoint is the secret data key for the AlibabaCloud OSS endpoint.
+	KeyAlibabaCloudEndpoint = "endpoint"
+
+	// DefaultCAKey is the default configmap data key for the object storage CA certificate.
+	DefaultCAKey = "service-ca.crt"
+
+	// FileSystemDirectory is the path the filesystem storage volume is mounted to.
+	FileSystemDirectory = "/tmp/filesystem"
+
+	// MinIOBucket is the name of the bucket created in the operator-managed MinIO storage.
+	MinIOBucket = "loki"
+	// MinIOPort is the port the operator-managed MinIO storage listens on.
+	MinIOPort = 9000
+
+	fileSystemVolumeName = "filesystem"
+	fileSystemPodLabel   = "loki.grafana.com/filesystem-storage"
+
 	saTokenVolumeName            = "bound-sa-token"
 	saTokenExpiration      int64 = 3600
 	saTokenVolumeMountPath       = "/var/run/secrets/storage/serviceaccount"
@@ -16,3 +138,18 @@ const (
 
 	awsDefaultAudience = "sts.amazonaws.com"
 )
+
+// FileSystemClaimName is the name of the persistent volume claim backing the filesystem storage.
+func FileSystemClaimName(stackName string) string {
+	return fmt.Sprintf("%s-filesystem", stackName)
+}
+
+// MinIOName is the name of all objects of the operator-managed MinIO storage.
+func MinIOName(stackName string) string {
+	return fmt.Sprintf("%s-minio", stackName)
+}
+
+// MinIOEndpoint is the in-cluster endpoint of the operator-managed MinIO storage.
+func MinIOEndpoint(stackName, namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", MinIOName(stackName), namespace, MinIOPort)
+}
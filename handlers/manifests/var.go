@@ -22,6 +22,13 @@ const (
 	lokiLivenessPath         = "/loki/api/v1/status/buildinfo"
 	lokiReadinessPath        = "/ready"
 	configVolumeName         = "config"
+	runtimeConfigVolumeName  = "runtime-config"
+
+	gatewayContainerName    = "gateway"
+	gatewayHTTPPort         = 8080
+	gatewayInternalPort     = 8081
+	gatewayHTTPPortName     = "public"
+	gatewayInternalPortName = "metrics"
 
 	gossipPort                       = 7946
 	gossipInstanceAddrEnvVarName     = "HASH_RING_INSTANCE_ADDR"
@@ -32,6 +39,12 @@ const (
 	AnnotationLokiConfigHash string = "loki.grafana.com/config-hash"
 	// AnnotationLokiObjectStoreHash stores the last SHA1 hash of the loki object storage credetials.
 	AnnotationLokiObjectStoreHash string = "loki.grafana.com/object-store-hash"
+	// AnnotationLokiObjectStoreCAHash stores the last SHA1 hash of the loki object storage CA certificate.
+	AnnotationLokiObjectStoreCAHash string = "loki.grafana.com/object-store-ca-hash"
+	// AnnotationLokiRulerSecretHash stores the last SHA1 hash of the ruler alertmanager secrets.
+	AnnotationLokiRulerSecretHash string = "loki.grafana.com/ruler-secret-hash"
+	// AnnotationLokiGatewayConfigHash stores the last SHA1 hash of the lokistack-gateway configuration
+	AnnotationLokiGatewayConfigHash string = "loki.grafana.com/gateway-config-hash"
 
 	// LabelCompactorComponent is the label value for the compactor component
 	LabelCompactorComponent string = "compactor"
@@ -56,6 +69,12 @@ const (
 	// DefaultContainerImage declares the default fallback for loki image.
 	DefaultContainerImage = "docker.io/grafana/loki:3.1.1"
 
+	// DefaultLokiStackGatewayImage declares the default image for lokiStack-gateway.
+	DefaultLokiStackGatewayImage = "quay.io/observatorium/api:main-2024-09-30-9bd4ae4"
+
+	// DefaultLokiStackGatewayPassthroughImage declares the image for lokiStack-gateway in passthrough mode.
+	DefaultLokiStackGatewayPassthroughImage = "docker.io/nginxinc/nginx-unprivileged:1.25-alpine"
+
 	kubernetesComponentLabel = "app.kubernetes.io/component"
 	kubernetesInstanceLabel  = "app.kubernetes.io/instance"
 
@@ -65,8 +84,29 @@ const (
 	kubernetesNodeOSLinux       = "linux"
 	kubernetesNodeHostnameLabel = "kubernetes.io/hostname"
 
-	dataDirectory     = "/tmp/loki"
-	storageVolumeName = "storage"
+	dataDirectory        = "/tmp/loki"
+	storageVolumeName    = "storage"
+	ingesterWALDirectory = dataDirectory + "/wal"
+
+	walDirectory          = "/tmp/wal"
+	walVolumeName         = "wal"
+	rulesStorageDirectory = "/tmp/rules"
+	rulesVolumeName       = "rules"
+
+	rulerSecretUsernameKey    = "username"
+	rulerSecretPasswordKey    = "password"
+	rulerSecretBearerTokenKey = "bearer_token"
+
+	envRulerRemoteWriteUsername    = "RULER_REMOTE_WRITE_USERNAME"
+	envRulerRemoteWritePassword    = "RULER_REMOTE_WRITE_PASSWORD"
+	envRulerRemoteWriteBearerToken = "RULER_REMOTE_WRITE_BEARER_TOKEN"
+
+	envRulerAlertManagerPassword    = "RULER_ALERTMANAGER_PASSWORD"
+	envRulerAlertManagerCredentials = "RULER_ALERTMANAGER_CREDENTIALS"
+
+	alertManagerCredentialsVolumeName = "alertmanager-credentials"
+	alertManagerCredentialsDirectory  = "/etc/ruler/alertmanager"
+	alertManagerCredentialsFileName   = "credentials"
 
 	saTokenVolumeName            = "bound-sa-token"
 	saTokenExpiration      int64 = 3600
@@ -98,6 +138,9 @@ const (
 
 	gatewayReadDuration  = 30 * time.Second
 	gatewayWriteDuration = 2 * time.Minute
+
+	defaultRulerEvaluationInterval = "1m"
+	defaultRulerPollInterval       = "1m"
 )
 
 var (
@@ -229,10 +272,29 @@ func commonAnnotations(opts Options) map[string]string {
 	return a
 }
 
+// storageAnnotations returns the common annotations extended by the object storage
+// secret and CA certificate hashes for components mounting them, so they roll on
+// credential or CA changes.
+func storageAnnotations(opts Options) map[string]string {
+	a := commonAnnotations(opts)
+	if opts.ObjectStorage.SecretSHA1 != "" {
+		a[AnnotationLokiObjectStoreHash] = opts.ObjectStorage.SecretSHA1
+	}
+	if tls := opts.ObjectStorage.TLS; tls != nil && tls.CASHA1 != "" {
+		a[AnnotationLokiObjectStoreCAHash] = tls.CASHA1
+	}
+
+	return a
+}
+
 func lokiConfigMapName(stackName string) string {
 	return fmt.Sprintf("%s-config", stackName)
 }
 
+func lokiRuntimeConfigMapName(stackName string) string {
+	return fmt.Sprintf("%s-runtime-config", stackName)
+}
+
 // CompactorName is the name of the compactor statefulset
 func CompactorName(stackName string) string {
 	return fmt.Sprintf("%s-compactor", stackName)
@@ -248,6 +310,11 @@ func IngesterName(stackName string) string {
 	return fmt.Sprintf("%s-ingester", stackName)
 }
 
+// IngesterZoneName is the name of the ingester statefulset of a zone
+func IngesterZoneName(stackName, zone string) string {
+	return fmt.Sprintf("%s-ingester-%s", stackName, zone)
+}
+
 // QuerierName is the name of the querier deployment
 func QuerierName(stackName string) string {
 	return fmt.Sprintf("%s-querier", stackName)
@@ -263,6 +330,21 @@ func IndexGatewayName(stackName string) string {
 	return fmt.Sprintf("%s-index-gateway", stackName)
 }
 
+// RulerName is the name of the ruler statefulset
+func RulerName(stackName string) string {
+	return fmt.Sprintf("%s-ruler", stackName)
+}
+
+// RulesConfigMapName is the name of a config map shard containing the ruler rule files
+func RulesConfigMapName(stackName string, shard int) string {
+	return fmt.Sprintf("%s-rules-%d", stackName, shard)
+}
+
+// GatewayName is the name of the lokiStack-gateway deployment
+func GatewayName(stackName string) string {
+	return fmt.Sprintf("%s-gateway", stackName)
+}
+
 func serviceNameQuerierHTTP(stackName string) string {
 	return fmt.Sprintf("%s-querier-http", stackName)
 }
@@ -311,6 +393,23 @@ func serviceNameIndexGatewayGRPC(stackName string) string {
 	return fmt.Sprintf("%s-index-gateway-grpc", stackName)
 }
 
+func serviceNameRulerHTTP(stackName string) string {
+	return fmt.Sprintf("%s-ruler-http", stackName)
+}
+
+func serviceNameRulerGRPC(stackName string) string {
+	return fmt.Sprintf("%s-ruler-grpc", stackName)
+}
+
+func serviceNameGatewayHTTP(stackName string) string {
+	return fmt.Sprintf("%s-gateway-http", stackName)
+}
+
+// CompactorHTTPURL returns the in-cluster base URL of the compactor HTTP endpoint
+func CompactorHTTPURL(stackName, namespace string) string {
+	return fmt.Sprintf("http://%s:%d", fqdn(serviceNameCompactorHTTP(stackName), namespace), httpPort)
+}
+
 func fqdn(serviceName, namespace string) string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace)
 }
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"context"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+)
+
+// Cleanup removes the rules config map shards not part of the desired objects.
+// If the ruler is disabled, the ruler statefulset, services and pod disruption budget are removed as well.
+func Cleanup(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack, desired []client.Object) error {
+	keep := map[string]struct{}{}
+	for _, obj := range desired {
+		if _, ok := obj.(*corev1.ConfigMap); ok {
+			keep[obj.GetName()] = struct{}{}
+		}
+	}
+
+	var cml corev1.ConfigMapList
+	opts := []client.ListOption{
+		client.InNamespace(stack.Namespace),
+		client.MatchingLabels(manifests.ComponentLabels(manifests.LabelRulerComponent, stack.Name)),
+	}
+	if err := k.List(ctx, &cml, opts...); err != nil {
+		return kverrors.Wrap(err, "failed to list rules configmaps", "name", stack.Name)
+	}
+
+	for i := range cml.Items {
+		cm := &cml.Items[i]
+		if _, ok := keep[cm.Name]; ok {
+			continue
+		}
+
+		if err := k.Delete(ctx, cm, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return kverrors.Wrap(err, "failed to delete rules configmap", "name", cm.Name)
+		}
+	}
+
+	if stack.Spec.Rules != nil && stack.Spec.Rules.Enabled {
+		return nil
+	}
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      manifests.RulerName(stack.Name),
+			Namespace: stack.Namespace,
+		},
+	}
+	if err := k.Delete(ctx, sts, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return kverrors.Wrap(err, "failed to delete ruler statefulset", "name", sts.Name)
+	}
+
+	var svcl corev1.ServiceList
+	if err := k.List(ctx, &svcl, opts...); err != nil {
+		return kverrors.Wrap(err, "failed to list ruler services", "name", stack.Name)
+	}
+
+	for i := range svcl.Items {
+		svc := &svcl.Items[i]
+		if err := k.Delete(ctx, svc, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return kverrors.Wrap(err, "failed to delete ruler service", "name", svc.Name)
+		}
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      manifests.RulerName(stack.Name),
+			Namespace: stack.Namespace,
+		},
+	}
+	if err := k.Delete(ctx, pdb, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return kverrors.Wrap(err, "failed to delete ruler pod disruption budget", "name", pdb.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+// GetRulerConfig returns the ruler config spec for a LokiStack resource and the hash of
+// the alertmanager secrets it references or an error. The RulerConfig resource is expected
+// to have the same name and namespace as the LokiStack. If it does not exist, it returns nil.
+func GetRulerConfig(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) (*lokiv1.RulerConfigSpec, string, error) {
+	if stack.Spec.Rules == nil || !stack.Spec.Rules.Enabled {
+		return nil, "", nil
+	}
+
+	var rc lokiv1.RulerConfig
+	key := client.ObjectKey{Name: stack.Name, Namespace: stack.Namespace}
+	if err := k.Get(ctx, key, &rc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, "", nil
+		}
+		return nil, "", kverrors.Wrap(err, "failed to get rulerconfig", "key", key)
+	}
+
+	if err := validateRulerConfig(&rc.Spec); err != nil {
+		return nil, "", &status.DegradedError{
+			Message: fmt.Sprintf("Invalid RulerConfig: %s", err),
+			Reason:  lokiv1.ReasonInvalidRulerConfig,
+			Requeue: false,
+		}
+	}
+
+	secretSHA1, err := hashAlertManagerSecrets(ctx, k, stack.Namespace, &rc.Spec)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &rc.Spec, secretSHA1, nil
+}
+
+func validateRulerConfig(spec *lokiv1.RulerConfigSpec) error {
+	if err := validateAlertManagerSpec(spec.AlertManagerSpec); err != nil {
+		return err
+	}
+
+	for tenant, o := range spec.Overrides {
+		if tenant == "" {
+			return kverrors.New("overrides require a tenant name")
+		}
+
+		if err := validateAlertManagerSpec(o.AlertManagerOverrides); err != nil {
+			return kverrors.Wrap(err, "invalid alertmanager overrides", "tenant", tenant)
+		}
+
+		// The runtime config of the overrides can reference the header credentials only as file.
+		if am := o.AlertManagerOverrides; am != nil && am.Client != nil && am.Client.BasicAuth != nil && am.Client.BasicAuth.Password != nil {
+			return kverrors.New("alertmanager overrides do not support a basic auth password, use header auth instead", "tenant", tenant)
+		}
+	}
+
+	return nil
+}
+
+func validateAlertManagerSpec(spec *lokiv1.AlertManagerSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	if len(spec.Endpoints) == 0 {
+		return kverrors.New("alertmanager requires at least one endpoint")
+	}
+
+	for _, ep := range spec.Endpoints {
+		if err := validateURL(ep); err != nil {
+			return kverrors.Wrap(err, "invalid alertmanager endpoint", "endpoint", ep)
+		}
+	}
+
+	if spec.ExternalURL != "" {
+		if err := validateURL(spec.ExternalURL); err != nil {
+			return kverrors.Wrap(err, "invalid alertmanager external url", "url", spec.ExternalURL)
+		}
+	}
+
+	c := spec.Client
+	if c == nil {
+		return nil
+	}
+
+	if tls := c.TLS; tls != nil && (tls.CertPath == nil) != (tls.KeyPath == nil) {
+		return kverrors.New("alertmanager client TLS requires both certPath and keyPath")
+	}
+
+	if ha := c.HeaderAuth; ha != nil && ha.Credentials != nil && ha.CredentialsFile != nil {
+		return kverrors.New("alertmanager client header auth accepts only one of credentials and credentialsFile")
+	}
+
+	if ba := c.BasicAuth; ba != nil && ba.Username == nil && ba.Password != nil {
+		return kverrors.New("alertmanager client basic auth requires a username")
+	}
+
+	return nil
+}
+
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return kverrors.New("url requires a scheme and a host")
+	}
+
+	return nil
+}
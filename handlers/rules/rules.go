@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"context"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+)
+
+// List returns the alerting and recording rules selected by the LokiStack rules spec.
+// Rules are looked up in all namespaces matching the namespace selector
+// or in the LokiStack namespace if no namespace selector is given.
+func List(ctx context.Context, k k8s.Client, stackNs string, rs *lokiv1.RulesSpec) ([]lokiv1.AlertingRule, []lokiv1.RecordingRule, error) {
+	if rs == nil || !rs.Enabled {
+		return nil, nil, nil
+	}
+
+	nsl, err := selectRulesNamespaces(ctx, k, stackNs, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selector, err := selectorFrom(rs.Selector)
+	if err != nil {
+		return nil, nil, kverrors.Wrap(err, "failed to create rules selector", "selector", rs.Selector)
+	}
+
+	var (
+		alerts []lokiv1.AlertingRule
+		recs   []lokiv1.RecordingRule
+	)
+	for _, ns := range nsl {
+		opts := []client.ListOption{
+			client.InNamespace(ns),
+			client.MatchingLabelsSelector{Selector: selector},
+		}
+
+		var arl lokiv1.AlertingRuleList
+		if err := k.List(ctx, &arl, opts...); err != nil {
+			return nil, nil, kverrors.Wrap(err, "failed to list alerting rules", "namespace", ns)
+		}
+		alerts = append(alerts, arl.Items...)
+
+		var rrl lokiv1.RecordingRuleList
+		if err := k.List(ctx, &rrl, opts...); err != nil {
+			return nil, nil, kverrors.Wrap(err, "failed to list recording rules", "namespace", ns)
+		}
+		recs = append(recs, rrl.Items...)
+	}
+
+	return alerts, recs, nil
+}
+
+func selectRulesNamespaces(ctx context.Context, k k8s.Client, stackNs string, rs *lokiv1.RulesSpec) ([]string, error) {
+	if rs.NamespaceSelector == nil {
+		return []string{stackNs}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(rs.NamespaceSelector)
+	if err != nil {
+		return nil, kverrors.Wrap(err, "failed to create namespace selector", "selector", rs.NamespaceSelector)
+	}
+
+	var nsList corev1.NamespaceList
+	if err := k.List(ctx, &nsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, kverrors.Wrap(err, "failed to list namespaces for selector", "selector", rs.NamespaceSelector)
+	}
+
+	var ns []string
+	for _, item := range nsList.Items {
+		ns = append(ns, item.Name)
+	}
+
+	return ns, nil
+}
+
+func selectorFrom(ls *metav1.LabelSelector) (labels.Selector, error) {
+	if ls == nil {
+		return labels.Everything(), nil
+	}
+
+	return metav1.LabelSelectorAsSelector(ls)
+}
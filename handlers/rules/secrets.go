@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"sort"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+// ValidateRemoteWriteSecret checks that the secret referenced by the ruler
+// remote write client exists and provides the keys required by the
+// authorization type.
+func ValidateRemoteWriteSecret(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) error {
+	if !manifests.RemoteWriteEnabled(stack.Spec) {
+		return nil
+	}
+
+	c := stack.Spec.Rules.RemoteWrite.Client
+	if c.AuthorizationType == "" {
+		return nil
+	}
+
+	var s corev1.Secret
+	key := client.ObjectKey{Name: c.AuthorizationSecretName, Namespace: stack.Namespace}
+	if err := k.Get(ctx, key, &s); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &status.DegradedError{
+				Message: "Missing ruler remote write authorization secret",
+				Reason:  lokiv1.ReasonMissingRulerSecret,
+				Requeue: false,
+			}
+		}
+		return kverrors.Wrap(err, "failed to lookup ruler remote write secret", "name", key)
+	}
+
+	for _, field := range manifests.RemoteWriteSecretKeys(c.AuthorizationType) {
+		if len(s.Data[field]) == 0 {
+			return &status.DegradedError{
+				Message: fmt.Sprintf("Invalid ruler remote write secret contents: missing %q field", field),
+				Reason:  lokiv1.ReasonInvalidRulerSecret,
+				Requeue: false,
+			}
+		}
+	}
+
+	return nil
+}
+
+// hashAlertManagerSecrets checks that the secret keys referenced by the alertmanager
+// clients of the ruler config and its tenant overrides exist. It returns a SHA1 hash
+// over the referenced secret data, which is empty if no secrets are referenced.
+func hashAlertManagerSecrets(ctx context.Context, k k8s.Client, namespace string, spec *lokiv1.RulerConfigSpec) (string, error) {
+	refs := AlertManagerSecretRefs(spec)
+	if len(refs) == 0 {
+		return "", nil
+	}
+
+	h := sha1.New()
+	for _, ref := range refs {
+		var s corev1.Secret
+		key := client.ObjectKey{Name: ref.Name, Namespace: namespace}
+		if err := k.Get(ctx, key, &s); err != nil {
+			if apierrors.IsNotFound(err) {
+				return "", &status.DegradedError{
+					Message: fmt.Sprintf("Missing ruler alertmanager secret %q", ref.Name),
+					Reason:  lokiv1.ReasonMissingRulerSecret,
+					Requeue: false,
+				}
+			}
+			return "", kverrors.Wrap(err, "failed to lookup ruler alertmanager secret", "name", key)
+		}
+
+		v := s.Data[ref.Key]
+		if len(v) == 0 {
+			return "", &status.DegradedError{
+				Message: fmt.Sprintf("Invalid ruler alertmanager secret contents: missing %q field", ref.Key),
+				Reason:  lokiv1.ReasonInvalidRulerSecret,
+				Requeue: false,
+			}
+		}
+
+		for _, b := range [][]byte{[]byte(ref.Name), {0x01}, []byte(ref.Key), {0x01}, v, {0xff}} {
+			if _, err := h.Write(b); err != nil {
+				return "", kverrors.Wrap(err, "failed to hash ruler alertmanager secret", "name", key)
+			}
+		}
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// AlertManagerSecretRefs returns the secret keys referenced by the alertmanager
+// clients of the ruler config and its tenant overrides, ordered by tenant.
+func AlertManagerSecretRefs(spec *lokiv1.RulerConfigSpec) []*corev1.SecretKeySelector {
+	refs := alertManagerSecretRefs(spec.AlertManagerSpec)
+
+	tenants := make([]string, 0, len(spec.Overrides))
+	for tenant := range spec.Overrides {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+
+	for _, tenant := range tenants {
+		refs = append(refs, alertManagerSecretRefs(spec.Overrides[tenant].AlertManagerOverrides)...)
+	}
+
+	return refs
+}
+
+func alertManagerSecretRefs(spec *lokiv1.AlertManagerSpec) []*corev1.SecretKeySelector {
+	if spec == nil || spec.Client == nil {
+		return nil
+	}
+
+	var refs []*corev1.SecretKeySelector
+	if ba := spec.Client.BasicAuth; ba != nil && ba.Password != nil {
+		refs = append(refs, ba.Password)
+	}
+	if ha := spec.Client.HeaderAuth; ha != nil && ha.Credentials != nil {
+		refs = append(refs, ha.Credentials)
+	}
+
+	return refs
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+func TestHashAlertManagerSecrets(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add client-go scheme: %s", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "alertmanager", Namespace: "test-ns"},
+		Data: map[string][]byte{
+			"password": []byte("secret"),
+		},
+	}
+	k := fake.NewClientBuilder().WithScheme(s).WithObjects(secret).Build()
+
+	spec := &lokiv1.RulerConfigSpec{
+		AlertManagerSpec: &lokiv1.AlertManagerSpec{
+			Client: &lokiv1.AlertManagerClientConfig{
+				BasicAuth: &lokiv1.AlertManagerClientBasicAuth{
+					Password: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanager"},
+						Key:                  "password",
+					},
+				},
+			},
+		},
+	}
+
+	hash, err := hashAlertManagerSecrets(context.Background(), k, "test-ns", spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash == "" {
+		t.Fatalf("missing hash for referenced secret")
+	}
+
+	secret.Data["password"] = []byte("rotated")
+	if err := k.Update(context.Background(), secret); err != nil {
+		t.Fatalf("failed to update secret: %s", err)
+	}
+
+	rotated, err := hashAlertManagerSecrets(context.Background(), k, "test-ns", spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if rotated == hash {
+		t.Errorf("hash unchanged for rotated secret")
+	}
+
+	spec.AlertManagerSpec.Client.BasicAuth.Password.Name = "missing"
+	_, err = hashAlertManagerSecrets(context.Background(), k, "test-ns", spec)
+
+	var de *status.DegradedError
+	if !errors.As(err, &de) || de.Reason != lokiv1.ReasonMissingRulerSecret {
+		t.Errorf("got error %v, want reason %s", err, lokiv1.ReasonMissingRulerSecret)
+	}
+
+	empty, err := hashAlertManagerSecrets(context.Background(), k, "test-ns", &lokiv1.RulerConfigSpec{})
+	if err != nil || empty != "" {
+		t.Errorf("got hash %q and error %v without referenced secrets, want none", empty, err)
+	}
+}
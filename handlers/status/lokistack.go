@@ -3,11 +3,12 @@ package status
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	// corev1 "k8s.io/api/core/v1"
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
-	// "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
@@ -20,6 +21,7 @@ const (
 	messageRunning                         = "All components are running, but some readiness checks are failing"
 	messageDegradedMissingNodes            = "Cluster contains no nodes matching the labels used for zone-awareness"
 	messageDegradedEmptyNodeLabel          = "No value for the labels used for zone-awareness"
+	messageDegradedInsufficientZones       = "Cluster contains %d zones for zone-awareness, but the replication factor requires %d"
 	messageWarningNeedsSchemaVersionUpdate = "The schema configuration does not contain the most recent schema version and needs an update"
 )
 
@@ -113,22 +115,30 @@ func generateCondition(ctx context.Context, cs *lokiv1.LokiStackComponentStatus,
 		len(cs.Ruler[lokiv1.PodPending])
 
 	if pending != 0 {
-		// if stack.Spec.Replication != nil && len(stack.Spec.Replication.Zones) > 0 {
-		// 	// When there are pending pods and zone-awareness is enabled check if there are any nodes
-		// 	// that can satisfy the constraints and emit a condition if not.
-		// 	nodesOk, labelsOk, err := checkForZoneawareNodes(ctx, k, stack.Spec.Replication.Zones)
-		// 	if err != nil {
-		// 		return metav1.Condition{}, err
-		// 	}
-
-		// 	if !nodesOk {
-		// 		return conditionDegradedNodeLabels, nil
-		// 	}
-
-		// 	if !labelsOk {
-		// 		return conditionDegradedEmptyNodeLabel, nil
-		// 	}
-		// }
+		if stack.Spec.Replication != nil && len(stack.Spec.Replication.Zones) > 0 {
+			// When there are pending pods and zone-awareness is enabled check if there are any nodes
+			// that can satisfy the constraints and emit a condition if not.
+			nodesOk, labelsOk, zones, err := CheckForZoneawareNodes(ctx, k, stack.Spec.Replication.Zones)
+			if err != nil {
+				return metav1.Condition{}, err
+			}
+
+			if !nodesOk {
+				return conditionDegradedNodeLabels, nil
+			}
+
+			if !labelsOk {
+				return conditionDegradedEmptyNodeLabel, nil
+			}
+
+			if factor := int(stack.Spec.Replication.Factor); zones < factor {
+				return metav1.Condition{
+					Type:    string(lokiv1.ConditionDegraded),
+					Message: fmt.Sprintf(messageDegradedInsufficientZones, zones, factor),
+					Reason:  string(lokiv1.ReasonZoneAwareInsufficientZones),
+				}, nil
+			}
+		}
 
 		return conditionPending, nil
 	}
@@ -150,6 +160,40 @@ func generateCondition(ctx context.Context, cs *lokiv1.LokiStackComponentStatus,
 	return conditionReady, nil
 }
 
+// CheckForZoneawareNodes checks that the cluster contains nodes with all labels used for
+// zone-awareness and that none of the labels is empty. It returns the number of distinct
+// zones, i.e. combinations of the label values, found on the nodes.
+func CheckForZoneawareNodes(ctx context.Context, k k8s.Client, zones []lokiv1.ZoneSpec) (nodesOk bool, labelsOk bool, zoneCount int, err error) {
+	nodeLabels := client.HasLabels{}
+	for _, z := range zones {
+		nodeLabels = append(nodeLabels, z.TopologyKey)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := k.List(ctx, nodeList, nodeLabels); err != nil {
+		return false, false, 0, kverrors.Wrap(err, "failed to list nodes for zone-awareness")
+	}
+
+	if len(nodeList.Items) == 0 {
+		return false, true, 0, nil
+	}
+
+	distinct := map[string]struct{}{}
+	for _, node := range nodeList.Items {
+		values := make([]string, 0, len(nodeLabels))
+		for _, nodeLabel := range nodeLabels {
+			value := node.Labels[nodeLabel]
+			if value == "" {
+				return true, false, 0, nil
+			}
+			values = append(values, value)
+		}
+		distinct[strings.Join(values, "_")] = struct{}{}
+	}
+
+	return true, true, len(distinct), nil
+}
+
 func generateWarnings(schemas []lokiv1.ObjectStorageSchema) []metav1.Condition {
 	warnings := make([]metav1.Condition, 0, 2)
 
@@ -11,8 +11,10 @@ import (
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
 )
 
-// SetStorageSchemaStatus updates the storage status component
-func SetStorageSchemaStatus(ctx context.Context, k k8s.Client, req ctrl.Request, schemas []lokiv1.ObjectStorageSchema) error {
+// SetStorageStatus updates the storage status component with the applied schemas,
+// the credential mode used for accessing the object storage and the hash of the
+// configuration verified by the object storage connectivity check.
+func SetStorageStatus(ctx context.Context, k k8s.Client, req ctrl.Request, schemas []lokiv1.ObjectStorageSchema, mode lokiv1.CredentialMode, preflightHash string) error {
 	var s lokiv1.LokiStack
 	if err := k.Get(ctx, req.NamespacedName, &s); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -22,5 +24,7 @@ func SetStorageSchemaStatus(ctx context.Context, k k8s.Client, req ctrl.Request,
 	}
 
 	s.Status.Storage.Schemas = schemas
+	s.Status.Storage.CredentialMode = mode
+	s.Status.Storage.PreflightHash = preflightHash
 	return k.Status().Update(ctx, &s)
 }
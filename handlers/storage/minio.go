@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+const (
+	minioAccessKeyIDBytes     = 10
+	minioSecretAccessKeyBytes = 20
+)
+
+// buildMinIOOptions returns the storage options pointing at the operator-managed MinIO
+// storage. The credentials are generated once and read back from the MinIO secret afterwards.
+func buildMinIOOptions(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) (storage.Options, error) {
+	if stack.Spec.Size != lokiv1.SizeOneXDemo {
+		return storage.Options{}, &status.DegradedError{
+			Message: fmt.Sprintf("Invalid MinIO storage: %s: %s", errMinIOSize, stack.Spec.Size),
+			Reason:  lokiv1.ReasonInvalidMinIOStorage,
+			Requeue: false,
+		}
+	}
+
+	cfg := &storage.MinIOStorageConfig{
+		StackName: stack.Name,
+		Endpoint:  storage.MinIOEndpoint(stack.Name, stack.Namespace),
+		Bucket:    storage.MinIOBucket,
+	}
+
+	var s corev1.Secret
+	key := client.ObjectKey{Name: storage.MinIOName(stack.Name), Namespace: stack.Namespace}
+	if err := k.Get(ctx, key, &s); err != nil && !apierrors.IsNotFound(err) {
+		return storage.Options{}, kverrors.Wrap(err, "failed to lookup minio secret", "name", key)
+	}
+
+	cfg.AccessKeyID = string(s.Data[storage.KeyAWSAccessKeyID])
+	cfg.SecretAccessKey = string(s.Data[storage.KeyAWSAccessKeySecret])
+
+	var err error
+	if cfg.AccessKeyID == "" {
+		cfg.AccessKeyID, err = randomHex(minioAccessKeyIDBytes)
+		if err != nil {
+			return storage.Options{}, kverrors.Wrap(err, "failed to generate minio access key id")
+		}
+	}
+	if cfg.SecretAccessKey == "" {
+		cfg.SecretAccessKey, err = randomHex(minioSecretAccessKeyBytes)
+		if err != nil {
+			return storage.Options{}, kverrors.Wrap(err, "failed to generate minio secret access key")
+		}
+	}
+
+	secretSHA1, err := hashSecretData(&corev1.Secret{Data: cfg.SecretData()})
+	if err != nil {
+		return storage.Options{}, kverrors.Wrap(err, "failed to hash minio secret", "name", key)
+	}
+
+	return storage.Options{
+		SharedStore:    lokiv1.ObjectStorageSecretS3,
+		CredentialMode: lokiv1.CredentialModeStatic,
+		S3: &storage.S3StorageConfig{
+			Endpoint:       cfg.Endpoint,
+			Buckets:        cfg.Bucket,
+			ForcePathStyle: true,
+		},
+		MinIO:      cfg,
+		SecretName: key.Name,
+		SecretSHA1: secretSHA1,
+		Schemas:    storage.BuildSchemas(stack.Spec.Storage.Schemas),
+	}, nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+const (
+	// preflightTimeout bounds the whole object storage connectivity check.
+	preflightTimeout = 10 * time.Second
+
+	preflightObjectPrefix = "loki-operator-preflight"
+)
+
+var errPreflightInvalidCA = errors.New("object storage CA certificate contains no valid PEM certificates")
+
+// preflightDisabled returns true if the LokiStack opts out of the object storage
+// connectivity check via annotation.
+func preflightDisabled(stack *lokiv1.LokiStack) bool {
+	v, ok := stack.Annotations[lokiv1.AnnotationDisableStoragePreflight]
+	if !ok {
+		return false
+	}
+
+	disabled, err := strconv.ParseBool(v)
+	return err == nil && disabled
+}
+
+// preflightHash returns the hash identifying the object storage configuration
+// checked by the preflight. The storage secret contains the endpoint, the buckets
+// and the credentials.
+func preflightHash(secretSHA1, caPEM string) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(secretSHA1))
+	_, _ = h.Write([]byte{0xff})
+	_, _ = h.Write([]byte(caPEM))
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// preflight checks that the object storage is reachable with the credentials of
+// the storage secret before rolling out the LokiStack components. Currently supported only by:
+// - S3 with static credentials
+func preflight(ctx context.Context, stack *lokiv1.LokiStack, opts storage.Options, s *corev1.Secret, caPEM string) error {
+	if opts.SharedStore != lokiv1.ObjectStorageSecretS3 || opts.CredentialMode != lokiv1.CredentialModeStatic {
+		return nil
+	}
+
+	c, err := newPreflightS3Client(opts, s, caPEM)
+	if err != nil {
+		return &status.DegradedError{
+			Message: fmt.Sprintf("Object storage preflight failed: %s", err),
+			Reason:  lokiv1.ReasonObjectStorageUnreachable,
+			Requeue: false,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	key := fmt.Sprintf("%s/%s-%s", preflightObjectPrefix, stack.Namespace, stack.Name)
+	for _, bucket := range strings.Split(opts.S3.Buckets, ",") {
+		bucket = strings.TrimSpace(bucket)
+		if bucket == "" {
+			continue
+		}
+
+		if err := checkS3Bucket(ctx, c, bucket, key); err != nil {
+			return preflightError(bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// checkS3Bucket verifies that the bucket exists and objects can be written, read and deleted.
+func checkS3Bucket(ctx context.Context, c *s3Client, bucket, key string) error {
+	if err := c.headBucket(ctx, bucket); err != nil {
+		return err
+	}
+
+	if err := c.putObject(ctx, bucket, key, []byte(key)); err != nil {
+		return err
+	}
+
+	if err := c.getObject(ctx, bucket, key); err != nil {
+		return err
+	}
+
+	return c.deleteObject(ctx, bucket, key)
+}
+
+func preflightError(bucket string, err error) error {
+	var se *s3StatusError
+	if errors.As(err, &se) {
+		switch se.Code {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return &status.DegradedError{
+				Message: fmt.Sprintf("Object storage access denied for bucket %q: %s", bucket, err),
+				Reason:  lokiv1.ReasonObjectStorageAccessDenied,
+				Requeue: false,
+			}
+		case http.StatusNotFound:
+			return &status.DegradedError{
+				Message: fmt.Sprintf("Object storage bucket %q not found: %s", bucket, err),
+				Reason:  lokiv1.ReasonObjectStorageUnreachable,
+				Requeue: false,
+			}
+		}
+	}
+
+	return &status.DegradedError{
+		Message: fmt.Sprintf("Object storage unreachable for bucket %q: %s", bucket, err),
+		Reason:  lokiv1.ReasonObjectStorageUnreachable,
+		Requeue: true,
+	}
+}
+
+func newPreflightS3Client(opts storage.Options, s *corev1.Secret, caPEM string) (*s3Client, error) {
+	cfg := opts.S3
+
+	region := cfg.Region
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("s3.%s.amazonaws.com", region)
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			return nil, errPreflightInvalidCA
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &s3Client{
+		endpoint:  u,
+		region:    region,
+		pathStyle: cfg.ForcePathStyle,
+		keyID:     string(s.Data[storage.KeyAWSAccessKeyID]),
+		secret:    string(s.Data[storage.KeyAWSAccessKeySecret]),
+		headers:   sseHeaders(cfg.SSE),
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   preflightTimeout,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// sseHeaders returns the request headers to write the probe object with the
+// configured server-side encryption, as buckets may enforce it by policy.
+func sseHeaders(sse storage.S3SSEConfig) map[string]string {
+	switch sse.Type {
+	case storage.SSES3Type:
+		return map[string]string{
+			"X-Amz-Server-Side-Encryption": "AES256",
+		}
+	case storage.SSEKMSType:
+		h := map[string]string{
+			"X-Amz-Server-Side-Encryption":                "aws:kms",
+			"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": sse.KMSKeyID,
+		}
+		if sse.KMSEncryptionContext != "" {
+			h["X-Amz-Server-Side-Encryption-Context"] = base64.StdEncoding.EncodeToString([]byte(sse.KMSEncryptionContext))
+		}
+		return h
+	default:
+		return nil
+	}
+}
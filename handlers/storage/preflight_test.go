@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
+)
+
+// fakeS3 records the requests of the preflight and answers with the status code
+// configured for the request method, defaulting to 200.
+type fakeS3 struct {
+	mu       sync.Mutex
+	codes    map[string]int
+	requests []*http.Request
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Clone(context.Background()))
+	if code, ok := s.codes[r.Method]; ok {
+		w.WriteHeader(code)
+	}
+}
+
+func testPreflightStack() *lokiv1.LokiStack {
+	return &lokiv1.LokiStack{
+		ObjectMeta: metav1.ObjectMeta{Name: "stack", Namespace: "ns"},
+	}
+}
+
+func testPreflightOptions(endpoint string, sse storage.S3SSEConfig) storage.Options {
+	return storage.Options{
+		SharedStore:    lokiv1.ObjectStorageSecretS3,
+		CredentialMode: lokiv1.CredentialModeStatic,
+		S3: &storage.S3StorageConfig{
+			Endpoint:       endpoint,
+			Buckets:        "loki",
+			ForcePathStyle: true,
+			SSE:            sse,
+		},
+	}
+}
+
+func testPreflightSecret() *corev1.Secret {
+	return &corev1.Secret{
+		Data: map[string][]byte{
+			storage.KeyAWSAccessKeyID:     []byte("id"),
+			storage.KeyAWSAccessKeySecret: []byte("secret"),
+		},
+	}
+}
+
+func TestPreflight_ChecksBucket(t *testing.T) {
+	s3 := &fakeS3{}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+
+	err := preflight(context.Background(), testPreflightStack(), testPreflightOptions(srv.URL, storage.S3SSEConfig{}), testPreflightSecret(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		"HEAD /loki",
+		"PUT /loki/loki-operator-preflight/ns-stack",
+		"GET /loki/loki-operator-preflight/ns-stack",
+		"DELETE /loki/loki-operator-preflight/ns-stack",
+	}
+	if len(s3.requests) != len(want) {
+		t.Fatalf("got %d requests, want %d", len(s3.requests), len(want))
+	}
+	for i, r := range s3.requests {
+		if got := r.Method + " " + r.URL.Path; got != want[i] {
+			t.Errorf("got request %q, want %q", got, want[i])
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Errorf("missing authorization for request %q", want[i])
+		}
+	}
+}
+
+func TestPreflight_SSEHeaders(t *testing.T) {
+	tt := []struct {
+		desc string
+		sse  storage.S3SSEConfig
+		want map[string]string
+	}{
+		{
+			desc: "none",
+			want: map[string]string{
+				"X-Amz-Server-Side-Encryption": "",
+			},
+		},
+		{
+			desc: "SSE-S3",
+			sse:  storage.S3SSEConfig{Type: storage.SSES3Type},
+			want: map[string]string{
+				"X-Amz-Server-Side-Encryption": "AES256",
+			},
+		},
+		{
+			desc: "SSE-KMS",
+			sse: storage.S3SSEConfig{
+				Type:                 storage.SSEKMSType,
+				KMSKeyID:             "key",
+				KMSEncryptionContext: `{"a":"b"}`,
+			},
+			want: map[string]string{
+				"X-Amz-Server-Side-Encryption":                "aws:kms",
+				"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "key",
+				"X-Amz-Server-Side-Encryption-Context":        base64.StdEncoding.EncodeToString([]byte(`{"a":"b"}`)),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			s3 := &fakeS3{}
+			srv := httptest.NewServer(s3)
+			defer srv.Close()
+
+			err := preflight(context.Background(), testPreflightStack(), testPreflightOptions(srv.URL, tc.sse), testPreflightSecret(), "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, r := range s3.requests {
+				if r.Method != http.MethodPut {
+					if v := r.Header.Get("X-Amz-Server-Side-Encryption"); v != "" {
+						t.Errorf("got SSE header %q for %s", v, r.Method)
+					}
+					continue
+				}
+
+				for k, want := range tc.want {
+					if got := r.Header.Get(k); got != want {
+						t.Errorf("got %s %q, want %q", k, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPreflight_Errors(t *testing.T) {
+	tt := []struct {
+		desc    string
+		codes   map[string]int
+		reason  lokiv1.LokiStackConditionReason
+		requeue bool
+	}{
+		{
+			desc:   "bucket access denied",
+			codes:  map[string]int{http.MethodHead: http.StatusForbidden},
+			reason: lokiv1.ReasonObjectStorageAccessDenied,
+		},
+		{
+			desc:   "write access denied",
+			codes:  map[string]int{http.MethodPut: http.StatusForbidden},
+			reason: lokiv1.ReasonObjectStorageAccessDenied,
+		},
+		{
+			desc:   "bucket not found",
+			codes:  map[string]int{http.MethodHead: http.StatusNotFound},
+			reason: lokiv1.ReasonObjectStorageUnreachable,
+		},
+		{
+			desc:    "server error",
+			codes:   map[string]int{http.MethodGet: http.StatusInternalServerError},
+			reason:  lokiv1.ReasonObjectStorageUnreachable,
+			requeue: true,
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			srv := httptest.NewServer(&fakeS3{codes: tc.codes})
+			defer srv.Close()
+
+			err := preflight(context.Background(), testPreflightStack(), testPreflightOptions(srv.URL, storage.S3SSEConfig{}), testPreflightSecret(), "")
+
+			var de *status.DegradedError
+			if !errors.As(err, &de) {
+				t.Fatalf("got error %v, want degraded error", err)
+			}
+			if de.Reason != tc.reason {
+				t.Errorf("got reason %s, want %s", de.Reason, tc.reason)
+			}
+			if de.Requeue != tc.requeue {
+				t.Errorf("got requeue %t, want %t", de.Requeue, tc.requeue)
+			}
+		})
+	}
+}
+
+func TestPreflight_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(&fakeS3{})
+	srv.Close()
+
+	err := preflight(context.Background(), testPreflightStack(), testPreflightOptions(srv.URL, storage.S3SSEConfig{}), testPreflightSecret(), "")
+
+	var de *status.DegradedError
+	if !errors.As(err, &de) {
+		t.Fatalf("got error %v, want degraded error", err)
+	}
+	if de.Reason != lokiv1.ReasonObjectStorageUnreachable || !de.Requeue {
+		t.Errorf("got reason %s and requeue %t, want %s and requeue", de.Reason, de.Requeue, lokiv1.ReasonObjectStorageUnreachable)
+	}
+}
+
+func TestPreflight_SkipsUnsupportedStorage(t *testing.T) {
+	opts := testPreflightOptions("http://127.0.0.1:1", storage.S3SSEConfig{})
+	opts.CredentialMode = lokiv1.CredentialModeToken
+
+	if err := preflight(context.Background(), testPreflightStack(), opts, testPreflightSecret(), ""); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
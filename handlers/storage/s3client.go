@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3DefaultRegion = "us-east-1"
+	s3Service       = "s3"
+	s3SigningAlgo   = "AWS4-HMAC-SHA256"
+)
+
+// s3Client is a minimal S3 client signing requests with AWS signature version 4.
+// It supports only the few bucket and object calls used by the storage preflight.
+type s3Client struct {
+	endpoint   *url.URL
+	region     string
+	pathStyle  bool
+	keyID      string
+	secret     string
+	headers    map[string]string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// s3StatusError is returned for non-successful S3 responses.
+type s3StatusError struct {
+	Op     string
+	Code   int
+	Status string
+}
+
+func (e *s3StatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Op, e.Status)
+}
+
+func (c *s3Client) headBucket(ctx context.Context, bucket string) error {
+	return c.do(ctx, "head bucket", http.MethodHead, bucket, "", nil, nil)
+}
+
+func (c *s3Client) putObject(ctx context.Context, bucket, key string, body []byte) error {
+	return c.do(ctx, "put object", http.MethodPut, bucket, key, body, c.headers)
+}
+
+func (c *s3Client) getObject(ctx context.Context, bucket, key string) error {
+	return c.do(ctx, "get object", http.MethodGet, bucket, key, nil, nil)
+}
+
+func (c *s3Client) deleteObject(ctx context.Context, bucket, key string) error {
+	return c.do(ctx, "delete object", http.MethodDelete, bucket, key, nil, nil)
+}
+
+func (c *s3Client) do(ctx context.Context, op, method, bucket, key string, body []byte, headers map[string]string) error {
+	u := *c.endpoint
+	p := "/" + url.PathEscape(bucket)
+	if !c.pathStyle {
+		u.Host = bucket + "." + u.Host
+		p = ""
+	}
+	if key != "" {
+		segments := strings.Split(key, "/")
+		for i, s := range segments {
+			segments[i] = url.PathEscape(s)
+		}
+		p += "/" + strings.Join(segments, "/")
+	}
+	if p == "" {
+		p = "/"
+	}
+	u.RawPath = p
+	u.Path, _ = url.PathUnescape(p)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	c.sign(req, body)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<20))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &s3StatusError{Op: op, Code: res.StatusCode, Status: res.Status}
+	}
+
+	return nil
+}
+
+// sign adds the AWS signature version 4 authorization header to the request.
+func (c *s3Client) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		lk := strings.ToLower(k)
+		if strings.HasPrefix(lk, "x-amz-") {
+			headers[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, c.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3SigningAlgo,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secret), date)
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, c.keyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
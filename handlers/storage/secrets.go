@@ -2,8 +2,13 @@ package storage
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,47 +32,294 @@ func getSecrets(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) (*co
 	return &storageSecret, nil
 }
 
+// hashSecretData returns a SHA1 hash over the sorted keys and values of the secret data.
+func hashSecretData(s *corev1.Secret) (string, error) {
+	keys := make([]string, 0, len(s.Data))
+	for k := range s.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha1.New()
+	for _, k := range keys {
+		for _, b := range [][]byte{[]byte(k), {0x01}, s.Data[k], {0xff}} {
+			if _, err := h.Write(b); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+var (
+	errSecretMissingField = errors.New("missing secret field")
+	errSecretUnknownType  = errors.New("unknown secret type")
+
+	errFileSystemSize     = errors.New("filesystem storage supports only size 1x.demo")
+	errFileSystemZones    = errors.New("filesystem storage does not support zone-aware replication")
+	errFileSystemReplicas = errors.New("filesystem storage supports only a single replica for component")
+
+	errS3StaticAndTokenCredentials      = errors.New("role_arn cannot be combined with access_key_id and access_key_secret")
+	errS3EndpointWithToken              = errors.New("role_arn cannot be combined with a custom endpoint")
+	errS3ForcePathStyleInvalid          = errors.New("forcepathstyle must be a boolean")
+	errS3SSEUnsupportedType             = errors.New("unsupported SSE type (supported: SSE-KMS, SSE-S3)")
+	errS3SSEKMSFieldsWithoutKMS         = errors.New("sse_kms_key_id and sse_kms_encryption_context require sse_type SSE-KMS")
+	errS3SSEKMSEncryptionContextInvalid = errors.New("sse_kms_encryption_context must be a JSON object")
+
+	errMinIOSize = errors.New("minio storage supports only size 1x.demo")
+
+	errAzureInvalidEnvironment = errors.New("azure environment invalid (valid values: AzureGlobal, AzureChinaCloud, AzureGermanCloud, AzureUSGovernment)")
+)
+
+var validAzureEnvironments = map[string]bool{
+	"AzureGlobal":       true,
+	"AzureChinaCloud":   true,
+	"AzureGermanCloud":  true,
+	"AzureUSGovernment": true,
+}
+
 func extractSecrets(secretSpec lokiv1.ObjectStorageSecretSpec, objStore *corev1.Secret) (storage.Options, error) {
 	storageOpts := storage.Options{
 		SecretName:  objStore.Name,
 		SharedStore: secretSpec.Type,
 	}
 
-	temp, err := extractS3ConfigSecret(objStore)
-
-	storageOpts.S3 = temp
+	var err error
+	switch secretSpec.Type {
+	case lokiv1.ObjectStorageSecretAzure:
+		storageOpts.Azure, err = extractAzureConfigSecret(objStore)
+	case lokiv1.ObjectStorageSecretGCS:
+		storageOpts.GCS, err = extractGCSConfigSecret(objStore)
+	case lokiv1.ObjectStorageSecretS3:
+		storageOpts.S3, err = extractS3ConfigSecret(objStore)
+	case lokiv1.ObjectStorageSecretSwift:
+		storageOpts.Swift, err = extractSwiftConfigSecret(objStore)
+	case lokiv1.ObjectStorageSecretAlibabaCloud:
+		storageOpts.AlibabaCloud, err = extractAlibabaCloudConfigSecret(objStore)
+	default:
+		return storage.Options{}, fmt.Errorf("%w: %s", errSecretUnknownType, secretSpec.Type)
+	}
 
 	if err != nil {
 		return storage.Options{}, err
 	}
 
+	storageOpts.CredentialMode = lokiv1.CredentialModeStatic
+	if storageOpts.S3 != nil && storageOpts.S3.STS {
+		storageOpts.CredentialMode = lokiv1.CredentialModeToken
+	}
+
 	return storageOpts, nil
 }
 
+func extractAzureConfigSecret(s *corev1.Secret) (*storage.AzureStorageConfig, error) {
+	// Extract and validate mandatory fields
+	env := string(s.Data[storage.KeyAzureEnvironmentName])
+	if env == "" {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAzureEnvironmentName)
+	}
+	if !validAzureEnvironments[env] {
+		return nil, fmt.Errorf("%w: %s", errAzureInvalidEnvironment, env)
+	}
+
+	container := s.Data[storage.KeyAzureStorageContainerName]
+	if len(container) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAzureStorageContainerName)
+	}
+
+	if len(s.Data[storage.KeyAzureStorageAccountName]) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAzureStorageAccountName)
+	}
+
+	if len(s.Data[storage.KeyAzureStorageAccountKey]) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAzureStorageAccountKey)
+	}
+
+	// Extract and validate optional fields
+	endpointSuffix := s.Data[storage.KeyAzureStorageEndpointSuffix]
+
+	return &storage.AzureStorageConfig{
+		Env:            env,
+		Container:      string(container),
+		EndpointSuffix: string(endpointSuffix),
+	}, nil
+}
+
+func extractGCSConfigSecret(s *corev1.Secret) (*storage.GCSStorageConfig, error) {
+	// Extract and validate mandatory fields
+	bucket := s.Data[storage.KeyGCPStorageBucketName]
+	if len(bucket) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyGCPStorageBucketName)
+	}
+
+	// Check if google authentication credentials is provided
+	keyJSON := s.Data[storage.KeyGCPServiceAccountKeyFilename]
+	if len(keyJSON) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyGCPServiceAccountKeyFilename)
+	}
+
+	return &storage.GCSStorageConfig{
+		Bucket: string(bucket),
+	}, nil
+}
+
 func extractS3ConfigSecret(s *corev1.Secret) (*storage.S3StorageConfig, error) {
-	buckets := s.Data["bucketnames"]
+	// Extract and validate mandatory fields
+	buckets := s.Data[storage.KeyAWSBucketNames]
 	if len(buckets) == 0 {
-		return nil, fmt.Errorf("%w: %s", errors.New("missing secret field"), "bucketnames")
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSBucketNames)
 	}
 
 	var (
-		endpoint = s.Data["endpoint"]
-		id       = s.Data["access_key_id"]
-		secret   = s.Data["access_key_secret"]
+		endpoint = s.Data[storage.KeyAWSEndpoint]
+		region   = s.Data[storage.KeyAWSRegion]
+		id       = s.Data[storage.KeyAWSAccessKeyID]
+		secret   = s.Data[storage.KeyAWSAccessKeySecret]
+		roleArn  = s.Data[storage.KeyAWSRoleArn]
+		audience = s.Data[storage.KeyAWSAudience]
 	)
 
+	sseCfg, err := extractS3SSEConfig(s.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &storage.S3StorageConfig{
 		Buckets: string(buckets),
+		Region:  string(region),
+		SSE:     sseCfg,
 	}
 
-	cfg.Endpoint = string(endpoint)
+	if len(roleArn) > 0 {
+		// Short-lived tokens are retrieved from AWS STS, static credentials and a custom endpoint do not apply.
+		if len(id) > 0 || len(secret) > 0 {
+			return nil, errS3StaticAndTokenCredentials
+		}
+		if len(endpoint) > 0 {
+			return nil, errS3EndpointWithToken
+		}
+		// In the STS case region is not an optional field
+		if len(region) == 0 {
+			return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSRegion)
+		}
+
+		cfg.STS = true
+		cfg.Audience = strings.TrimSpace(string(audience))
+		return cfg, nil
+	}
 
 	if len(id) == 0 {
-		return nil, fmt.Errorf("%w: %s", errors.New("missing secret field"), "access_key_id")
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSAccessKeyID)
 	}
 	if len(secret) == 0 {
-		return nil, fmt.Errorf("%w: %s", errors.New("missing secret field"), "access_key_secret")
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSAccessKeySecret)
+	}
+
+	// Without a custom endpoint the region selects the AWS endpoint.
+	if len(endpoint) == 0 && len(region) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSRegion)
 	}
 
+	// Extract and validate optional fields
+	if v := s.Data[storage.KeyAWSForcePathStyle]; len(v) > 0 {
+		cfg.ForcePathStyle, err = strconv.ParseBool(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errS3ForcePathStyleInvalid, v)
+		}
+	}
+
+	cfg.Endpoint = string(endpoint)
+
 	return cfg, nil
 }
+
+func extractS3SSEConfig(d map[string][]byte) (storage.S3SSEConfig, error) {
+	var (
+		sseType          = storage.S3SSEType(d[storage.KeyAWSSSEType])
+		kmsKeyID         = string(d[storage.KeyAWSSseKmsKeyID])
+		kmsEncryptionCtx = string(d[storage.KeyAWSSseKmsEncryptionContext])
+	)
+
+	switch sseType {
+	case storage.SSEKMSType:
+		if kmsKeyID == "" {
+			return storage.S3SSEConfig{}, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAWSSseKmsKeyID)
+		}
+		if kmsEncryptionCtx != "" && !json.Valid([]byte(kmsEncryptionCtx)) {
+			return storage.S3SSEConfig{}, fmt.Errorf("%w: %s", errS3SSEKMSEncryptionContextInvalid, storage.KeyAWSSseKmsEncryptionContext)
+		}
+	case storage.SSES3Type, "":
+		// The KMS settings only apply to SSE-KMS, reject them instead of silently ignoring them.
+		if kmsKeyID != "" || kmsEncryptionCtx != "" {
+			return storage.S3SSEConfig{}, fmt.Errorf("%w: %s", errS3SSEKMSFieldsWithoutKMS, sseType)
+		}
+		if sseType == "" {
+			return storage.S3SSEConfig{}, nil
+		}
+	default:
+		return storage.S3SSEConfig{}, fmt.Errorf("%w: %s", errS3SSEUnsupportedType, sseType)
+	}
+
+	return storage.S3SSEConfig{
+		Type:                 sseType,
+		KMSKeyID:             kmsKeyID,
+		KMSEncryptionContext: kmsEncryptionCtx,
+	}, nil
+}
+
+func extractSwiftConfigSecret(s *corev1.Secret) (*storage.SwiftStorageConfig, error) {
+	// Extract and validate mandatory fields
+	for _, key := range []string{
+		storage.KeySwiftAuthURL,
+		storage.KeySwiftUsername,
+		storage.KeySwiftPassword,
+		storage.KeySwiftContainerName,
+	} {
+		if len(s.Data[key]) == 0 {
+			return nil, fmt.Errorf("%w: %s", errSecretMissingField, key)
+		}
+	}
+
+	// Extract and validate optional fields
+	return &storage.SwiftStorageConfig{
+		AuthURL:           string(s.Data[storage.KeySwiftAuthURL]),
+		UserDomainName:    string(s.Data[storage.KeySwiftUserDomainName]),
+		UserDomainID:      string(s.Data[storage.KeySwiftUserDomainID]),
+		UserID:            string(s.Data[storage.KeySwiftUserID]),
+		DomainID:          string(s.Data[storage.KeySwiftDomainID]),
+		DomainName:        string(s.Data[storage.KeySwiftDomainName]),
+		ProjectID:         string(s.Data[storage.KeySwiftProjectID]),
+		ProjectName:       string(s.Data[storage.KeySwiftProjectName]),
+		ProjectDomainID:   string(s.Data[storage.KeySwiftProjectDomainID]),
+		ProjectDomainName: string(s.Data[storage.KeySwiftProjectDomainName]),
+		Region:            string(s.Data[storage.KeySwiftRegion]),
+		Container:         string(s.Data[storage.KeySwiftContainerName]),
+	}, nil
+}
+
+func extractAlibabaCloudConfigSecret(s *corev1.Secret) (*storage.AlibabaCloudStorageConfig, error) {
+	// Extract and validate mandatory fields
+	endpoint := s.Data[storage.KeyAlibabaCloudEndpoint]
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAlibabaCloudEndpoint)
+	}
+
+	bucket := s.Data[storage.KeyAlibabaCloudBucket]
+	if len(bucket) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAlibabaCloudBucket)
+	}
+
+	if len(s.Data[storage.KeyAlibabaCloudAccessKeyID]) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAlibabaCloudAccessKeyID)
+	}
+
+	if len(s.Data[storage.KeyAlibabaCloudSecretAccessKey]) == 0 {
+		return nil, fmt.Errorf("%w: %s", errSecretMissingField, storage.KeyAlibabaCloudSecretAccessKey)
+	}
+
+	return &storage.AlibabaCloudStorageConfig{
+		Endpoint: string(endpoint),
+		Bucket:   string(bucket),
+	}, nil
+}
@@ -2,8 +2,14 @@ package storage
 
 import (
 	"context"
+	"crypto/sha1"
 	"fmt"
 
+	"github.com/ViaQ/logerr/v2/kverrors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/external/k8s"
 
@@ -12,6 +18,37 @@ import (
 )
 
 func BuildOptions(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) (storage.Options, error) {
+	if stack.Spec.Storage.Secret.Type == lokiv1.ObjectStorageSecretFileSystem {
+		if err := validateFileSystemStack(stack); err != nil {
+			return storage.Options{}, &status.DegradedError{
+				Message: fmt.Sprintf("Invalid filesystem storage: %s", err),
+				Reason:  lokiv1.ReasonInvalidFileSystemStorage,
+				Requeue: false,
+			}
+		}
+
+		return storage.Options{
+			SharedStore: lokiv1.ObjectStorageSecretFileSystem,
+			FileSystem: &storage.FileSystemStorageConfig{
+				StackName: stack.Name,
+				Directory: storage.FileSystemDirectory,
+			},
+			Schemas: storage.BuildSchemas(stack.Spec.Storage.Schemas),
+		}, nil
+	}
+
+	if stack.Spec.Storage.Secret.Type == lokiv1.ObjectStorageSecretMinIO {
+		return buildMinIOOptions(ctx, k, stack)
+	}
+
+	if stack.Spec.Storage.Secret.Name == "" {
+		return storage.Options{}, &status.DegradedError{
+			Message: "Missing object storage secret name",
+			Reason:  lokiv1.ReasonMissingObjectStorageSecret,
+			Requeue: false,
+		}
+	}
+
 	storageSecret, err := getSecrets(ctx, k, stack)
 	if err != nil {
 		return storage.Options{}, err
@@ -26,9 +63,106 @@ func BuildOptions(ctx context.Context, k k8s.Client, stack *lokiv1.LokiStack) (s
 		}
 	}
 
+	objStore.SecretSHA1, err = hashSecretData(storageSecret)
+	if err != nil {
+		return storage.Options{}, kverrors.Wrap(err, "failed to hash object storage secret", "name", storageSecret.Name)
+	}
+
 	storageSchemas := storage.BuildSchemas(stack.Spec.Storage.Schemas)
 
 	objStore.Schemas = storageSchemas
 
+	var caPEM string
+	if tls := stack.Spec.Storage.TLS; tls != nil {
+		objStore.TLS, caPEM, err = buildTLSConfig(ctx, k, stack.Namespace, tls)
+		if err != nil {
+			return storage.Options{}, err
+		}
+	}
+
+	if !preflightDisabled(stack) {
+		objStore.PreflightHash = preflightHash(objStore.SecretSHA1, caPEM)
+
+		// Skip the check if the same configuration has been verified already.
+		if objStore.PreflightHash != stack.Status.Storage.PreflightHash {
+			if err := preflight(ctx, stack, objStore, storageSecret, caPEM); err != nil {
+				return storage.Options{}, err
+			}
+		}
+	}
+
 	return objStore, nil
 }
+
+// buildTLSConfig ensures that the CA configmap referenced by the object storage
+// TLS spec exists and contains the CA key. It returns the CA certificate next to the config.
+func buildTLSConfig(ctx context.Context, k k8s.Client, namespace string, tls *lokiv1.ObjectStorageTLSSpec) (*storage.TLSConfig, string, error) {
+	caKey := tls.CAKey
+	if caKey == "" {
+		caKey = storage.DefaultCAKey
+	}
+
+	var cm corev1.ConfigMap
+	key := client.ObjectKey{Name: tls.CA, Namespace: namespace}
+	if err := k.Get(ctx, key, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, "", &status.DegradedError{
+				Message: "Missing object storage CA config map",
+				Reason:  lokiv1.ReasonMissingObjectStorageCAConfigMap,
+				Requeue: true,
+			}
+		}
+		return nil, "", kverrors.Wrap(err, "failed to lookup object storage CA config map", "name", key)
+	}
+
+	ca := cm.Data[caKey]
+	if ca == "" {
+		return nil, "", &status.DegradedError{
+			Message: fmt.Sprintf("Invalid object storage CA config map: missing key %q", caKey),
+			Reason:  lokiv1.ReasonInvalidObjectStorageCAConfigMap,
+			Requeue: false,
+		}
+	}
+
+	return &storage.TLSConfig{
+		CA:     tls.CA,
+		Key:    caKey,
+		CASHA1: fmt.Sprintf("%x", sha1.Sum([]byte(ca))),
+	}, ca, nil
+}
+
+// validateFileSystemStack ensures that a single replica of each component accessing
+// the storage is running, because they all share a single ReadWriteOnce volume.
+func validateFileSystemStack(stack *lokiv1.LokiStack) error {
+	if stack.Spec.Size != lokiv1.SizeOneXDemo {
+		return fmt.Errorf("%w: %s", errFileSystemSize, stack.Spec.Size)
+	}
+
+	if r := stack.Spec.Replication; r != nil && len(r.Zones) > 0 {
+		return errFileSystemZones
+	}
+
+	t := stack.Spec.Template
+	if t == nil {
+		return nil
+	}
+
+	components := []struct {
+		name string
+		spec *lokiv1.LokiComponentSpec
+	}{
+		{name: "compactor", spec: t.Compactor},
+		{name: "ingester", spec: t.Ingester},
+		{name: "querier", spec: t.Querier},
+		{name: "index gateway", spec: t.IndexGateway},
+		{name: "ruler", spec: t.Ruler},
+	}
+
+	for _, c := range components {
+		if c.spec != nil && c.spec.Replicas > 1 {
+			return fmt.Errorf("%w: %s", errFileSystemReplicas, c.name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests/storage"
+)
+
+func TestBuildOptions_SkipsVerifiedPreflight(t *testing.T) {
+	s3 := &fakeS3{}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add client-go scheme: %s", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: "ns"},
+		Data: map[string][]byte{
+			storage.KeyAWSBucketNames:     []byte("loki"),
+			storage.KeyAWSEndpoint:        []byte(srv.URL),
+			storage.KeyAWSAccessKeyID:     []byte("id"),
+			storage.KeyAWSAccessKeySecret: []byte("secret"),
+			storage.KeyAWSForcePathStyle:  []byte("true"),
+		},
+	}
+	k := fake.NewClientBuilder().WithScheme(s).WithObjects(secret).Build()
+
+	stack := testPreflightStack()
+	stack.Spec.Storage.Secret = lokiv1.ObjectStorageSecretSpec{
+		Type: lokiv1.ObjectStorageSecretS3,
+		Name: secret.Name,
+	}
+
+	opts, err := BuildOptions(context.Background(), k, stack)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(s3.requests) == 0 {
+		t.Fatalf("preflight not run for new storage configuration")
+	}
+	if opts.PreflightHash == "" {
+		t.Fatalf("missing preflight hash")
+	}
+
+	// The verified configuration is recorded in the status.
+	stack.Status.Storage.PreflightHash = opts.PreflightHash
+	s3.requests = nil
+
+	if _, err := BuildOptions(context.Background(), k, stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(s3.requests) != 0 {
+		t.Errorf("got %d preflight requests for verified storage configuration, want none", len(s3.requests))
+	}
+
+	secret.Data[storage.KeyAWSAccessKeySecret] = []byte("rotated")
+	if err := k.Update(context.Background(), secret); err != nil {
+		t.Fatalf("failed to update secret: %s", err)
+	}
+
+	opts, err = BuildOptions(context.Background(), k, stack)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(s3.requests) == 0 {
+		t.Errorf("preflight not run for changed storage secret")
+	}
+	if opts.PreflightHash == stack.Status.Storage.PreflightHash {
+		t.Errorf("preflight hash unchanged for changed storage secret")
+	}
+}
+
+func TestBuildTLSConfig_HashesCA(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add client-go scheme: %s", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"},
+		Data: map[string]string{
+			storage.DefaultCAKey: "ca-1",
+		},
+	}
+	k := fake.NewClientBuilder().WithScheme(s).WithObjects(cm).Build()
+	spec := &lokiv1.ObjectStorageTLSSpec{CA: cm.Name}
+
+	tls, _, err := buildTLSConfig(context.Background(), k, "ns", spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tls.CASHA1 == "" {
+		t.Fatalf("missing CA hash")
+	}
+
+	cm.Data[storage.DefaultCAKey] = "ca-2"
+	if err := k.Update(context.Background(), cm); err != nil {
+		t.Fatalf("failed to update config map: %s", err)
+	}
+
+	rotated, _, err := buildTLSConfig(context.Background(), k, "ns", spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if rotated.CASHA1 == tls.CASHA1 {
+		t.Errorf("CA hash unchanged for rotated CA")
+	}
+}
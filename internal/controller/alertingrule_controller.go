@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+)
+
+// AlertingRuleReconciler reconciles a AlertingRule object
+type AlertingRuleReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=alertingrules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=alertingrules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=alertingrules/finalizers,verbs=update
+
+// Reconcile marks all LokiStacks with enabled rules for a new reconciliation,
+// so that the ruler picks up created, changed or deleted alerting rules.
+func (r *AlertingRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	err := handlers.AnnotateForDiscoveredRules(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *AlertingRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&lokiv1.AlertingRule{}).
+		Complete(r)
+}
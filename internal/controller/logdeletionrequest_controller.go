@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/manifests"
+)
+
+// logDeletionRequestPollInterval is the interval to poll the compactor for pending requests.
+const logDeletionRequestPollInterval = time.Minute
+
+// LogDeletionRequestReconciler reconciles a LogDeletionRequest object
+type LogDeletionRequestReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+
+	// CompactorURL returns the base URL of the compactor delete API for a LokiStack.
+	// Defaults to the in-cluster compactor HTTP service.
+	CompactorURL func(stackName, namespace string) string
+}
+
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=logdeletionrequests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=logdeletionrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=logdeletionrequests/finalizers,verbs=update
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=lokistacks,verbs=get;list;watch
+
+// Reconcile submits a LogDeletionRequest to the compactor of its LokiStack
+// and polls the compactor until the request is processed.
+func (r *LogDeletionRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	compactorURL := r.CompactorURL
+	if compactorURL == nil {
+		compactorURL = manifests.CompactorHTTPURL
+	}
+
+	pending, err := handlers.ProcessLogDeletionRequest(ctx, r.Log, req, r.Client, compactorURL, time.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if pending {
+		return ctrl.Result{RequeueAfter: logDeletionRequestPollInterval}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *LogDeletionRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&lokiv1.LogDeletionRequest{}).
+		Complete(r)
+}
@@ -22,12 +22,19 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/rules"
 	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers/status"
 )
 
@@ -45,10 +52,14 @@ type LokiStackReconciler struct {
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
-//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;delete
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings;clusterroles;roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=loki.lightweight.com,resources=canaries,verbs=get;list;watch
 //+kubebuilder:rbac:groups=loki.lightweight.com,resources=promtails,verbs=get;list;watch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=alertingrules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=recordingrules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=rulerconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=persistentvolumes;persistentvolumeclaims,verbs=get;list;watch;create;update;delete
 
 
@@ -90,5 +101,77 @@ func (r *LokiStackReconciler) updateResources(ctx context.Context, req ctrl.Requ
 func (r *LokiStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&lokiv1.LokiStack{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.enqueueForSecret)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.enqueueForStorageCA)).
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(ingesterZonePredicate)).
 		Complete(r)
 }
+
+// ingesterZonePredicate selects the ingester StatefulSets of the statefulset-per-zone
+// zone mode to continue their rollout once a zone becomes ready.
+var ingesterZonePredicate = predicate.NewPredicateFuncs(func(obj client.Object) bool {
+	_, ok := obj.GetLabels()[lokiv1.LabelIngesterZone]
+	return ok
+})
+
+// enqueueForSecret maps a secret to the LokiStacks in the same namespace referencing
+// it as object storage secret or by the alertmanager clients of their RulerConfig.
+func (r *LokiStackReconciler) enqueueForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	var stacks lokiv1.LokiStackList
+	if err := r.List(ctx, &stacks, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list lokistacks for secret", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var rcs lokiv1.RulerConfigList
+	if err := r.List(ctx, &rcs, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list rulerconfigs for secret", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	// The RulerConfig of a LokiStack has the same name as the LokiStack.
+	rulerSecret := map[string]bool{}
+	for i := range rcs.Items {
+		for _, ref := range rules.AlertManagerSecretRefs(&rcs.Items[i].Spec) {
+			if ref.Name == obj.GetName() {
+				rulerSecret[rcs.Items[i].Name] = true
+			}
+		}
+	}
+
+	var requests []reconcile.Request
+	for _, stack := range stacks.Items {
+		if stack.Spec.Storage.Secret.Name != obj.GetName() && !rulerSecret[stack.Name] {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&stack),
+		})
+	}
+
+	return requests
+}
+
+// enqueueForStorageCA maps a config map to the LokiStacks in the same namespace
+// referencing it as object storage CA bundle.
+func (r *LokiStackReconciler) enqueueForStorageCA(ctx context.Context, obj client.Object) []reconcile.Request {
+	var stacks lokiv1.LokiStackList
+	if err := r.List(ctx, &stacks, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list lokistacks for storage CA", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, stack := range stacks.Items {
+		if tls := stack.Spec.Storage.TLS; tls == nil || tls.CA != obj.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&stack),
+		})
+	}
+
+	return requests
+}
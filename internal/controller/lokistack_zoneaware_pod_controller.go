@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+)
+
+// LokiStackZoneAwarePodReconciler annotates scheduled zone-aware LokiStack pods
+// with the availability zone of their node.
+type LokiStackZoneAwarePodReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+
+// Reconcile sets the availability zone annotation the init container of
+// zone-aware pods waits for.
+func (r *LokiStackZoneAwarePodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var pod corev1.Pod
+	if err := r.Get(ctx, req.NamespacedName, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := handlers.AnnotatePodWithAvailabilityZone(ctx, r.Log, r.Client, &pod); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *LokiStackZoneAwarePodReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("zoneawarepod").
+		For(&corev1.Pod{}, builder.WithPredicates(predicate.NewPredicateFuncs(needsAvailabilityZone))).
+		Complete(r)
+}
+
+// needsAvailabilityZone selects zone-aware pods scheduled to a node
+// but not yet annotated with their availability zone.
+func needsAvailabilityZone(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+
+	return pod.Labels[lokiv1.LabelZoneAwarePod] != "" &&
+		pod.Spec.NodeName != "" &&
+		pod.Annotations[lokiv1.AnnotationAvailabilityZone] == ""
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+)
+
+// RecordingRuleReconciler reconciles a RecordingRule object
+type RecordingRuleReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=recordingrules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=recordingrules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=recordingrules/finalizers,verbs=update
+
+// Reconcile marks all LokiStacks with enabled rules for a new reconciliation,
+// so that the ruler picks up created, changed or deleted recording rules.
+func (r *RecordingRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	err := handlers.AnnotateForDiscoveredRules(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *RecordingRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&lokiv1.RecordingRule{}).
+		Complete(r)
+}
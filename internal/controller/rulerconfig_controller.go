@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lokiv1 "github.com/LokiGraduationProject/light-weight-loki-operator/api/v1"
+	"github.com/LokiGraduationProject/light-weight-loki-operator/handlers"
+)
+
+// RulerConfigReconciler reconciles a RulerConfig object
+type RulerConfigReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=rulerconfigs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=rulerconfigs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=loki.lightweight.com,resources=rulerconfigs/finalizers,verbs=update
+
+// Reconcile marks the LokiStack with the same name and namespace as the
+// RulerConfig for a new reconciliation.
+func (r *RulerConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	err := handlers.AnnotateForRulerConfig(ctx, r.Client, req.Name, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *RulerConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&lokiv1.RulerConfig{}).
+		Complete(r)
+}